@@ -0,0 +1,511 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"gopkg.in/yaml.v3"
+)
+
+// -assertions 파일 (YAML 또는 JSON)
+//
+//	checks:
+//	  - name: Get users          # 요청 이름 (생략하면 folder의 모든 요청)
+//	    folder: Users            # 폴더 경로 (하위 폴더 포함)
+//	    status: [200, 201]       # 200, "2xx", "200-204"도 가능
+//	    headers:
+//	      Content-Type: /^application\/json/
+//	    json:
+//	      - path: $.data[0].id
+//	        equals: 123
+//	    body:
+//	      contains: ok
+//	    max_response_time: 500ms
+//	    schema: schemas/user.json  # JSON Schema 파일 (검증 파일 기준 상대 경로)
+type assertionFile struct {
+	Checks []requestCheck `yaml:"checks"`
+}
+
+// 요청 이름/폴더로 대상을 고르는 선언적 검증 하나
+type requestCheck struct {
+	Name            string             `yaml:"name"`
+	Folder          string             `yaml:"folder"`
+	Status          *statusExpectation `yaml:"status"`
+	Headers         map[string]string  `yaml:"headers"` // 값이 /.../ 형태면 정규식
+	JSON            []jsonCheck        `yaml:"json"`
+	Body            *bodyCheck         `yaml:"body"`
+	MaxResponseTime *durationValue     `yaml:"max_response_time"`
+	Schema          string             `yaml:"schema"`
+
+	headerPatterns map[string]*regexp.Regexp
+	schema         *jsonschema.Schema
+}
+
+type jsonCheck struct {
+	Path   string      `yaml:"path"`
+	Equals interface{} `yaml:"equals"`
+	Exists *bool       `yaml:"exists"`
+	Type   string      `yaml:"type"` // string, number, integer, boolean, array, object, null
+
+	hasEquals bool
+	path      *jsonPath
+}
+
+type bodyCheck struct {
+	Contains string `yaml:"contains"`
+	Matches  string `yaml:"matches"`
+
+	pattern *regexp.Regexp
+}
+
+// 로드한 검증 목록 (nil이면 기본 검증(상태 코드 2xx)만 수행)
+type assertionSet struct {
+	checks []requestCheck
+}
+
+var jsonTypeNames = []string{"string", "number", "integer", "boolean", "array", "object", "null"}
+
+// 검증 파일 로드 (정규식과 JSONPath는 미리 검사해서 실행 전에 오류를 알린다)
+func loadAssertions(path string) (*assertionSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("검증 파일 읽기 실패: %v", err)
+	}
+
+	var file assertionFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("검증 파일 파싱 실패: %s: %v", path, err)
+	}
+
+	schemas := newSchemaCompiler()
+	for i := range file.Checks {
+		check := &file.Checks[i]
+		if check.Schema != "" && !filepath.IsAbs(check.Schema) {
+			check.Schema = filepath.Join(filepath.Dir(path), check.Schema)
+		}
+		if err := check.compile(schemas); err != nil {
+			return nil, fmt.Errorf("%s: checks[%d]: %v", path, i, err)
+		}
+	}
+	return &assertionSet{checks: file.Checks}, nil
+}
+
+func (c *requestCheck) compile(schemas *schemaCompiler) error {
+	if c.Name == "" && c.Folder == "" {
+		return fmt.Errorf("name 또는 folder 중 하나는 지정해야 합니다")
+	}
+	c.Folder = strings.Trim(c.Folder, "/")
+
+	c.headerPatterns = make(map[string]*regexp.Regexp)
+	for name, expected := range c.Headers {
+		if pattern, ok := slashPattern(expected); ok {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return fmt.Errorf("헤더 %s의 정규식 오류: %v", name, err)
+			}
+			c.headerPatterns[name] = re
+		}
+	}
+
+	for i := range c.JSON {
+		check := &c.JSON[i]
+		path, err := parseJSONPath(check.Path)
+		if err != nil {
+			return err
+		}
+		if path.isPattern() {
+			return fmt.Errorf("%s: 검증에는 와일드카드(*)나 재귀 탐색(..)을 쓸 수 없습니다", check.Path)
+		}
+		check.path = path
+		if check.Type != "" && !containsString(jsonTypeNames, check.Type) {
+			return fmt.Errorf("알 수 없는 JSON 타입: %s (사용 가능: %s)", check.Type, strings.Join(jsonTypeNames, ", "))
+		}
+		if !check.hasEquals && check.Exists == nil && check.Type == "" {
+			return fmt.Errorf("%s: equals, exists, type 중 하나는 지정해야 합니다", check.Path)
+		}
+	}
+
+	if c.Body != nil && c.Body.Matches != "" {
+		re, err := regexp.Compile(c.Body.Matches)
+		if err != nil {
+			return fmt.Errorf("body.matches 정규식 오류: %v", err)
+		}
+		c.Body.pattern = re
+	}
+
+	if c.Schema != "" {
+		schema, err := schemas.compile(c.Schema)
+		if err != nil {
+			return fmt.Errorf("스키마 로드 실패: %v", err)
+		}
+		c.schema = schema
+	}
+	return nil
+}
+
+// equals: null처럼 값이 null인 경우와 지정하지 않은 경우를 구분
+func (c *jsonCheck) UnmarshalYAML(node *yaml.Node) error {
+	type plain jsonCheck
+	if err := node.Decode((*plain)(c)); err != nil {
+		return err
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == "equals" {
+			c.hasEquals = true
+		}
+	}
+	return nil
+}
+
+// 요청에 적용되는 검증인지 여부 (이름이 같고 폴더가 같거나 하위 폴더)
+func (c *requestCheck) appliesTo(result *TestResult) bool {
+	return matchesScope(c.Name, c.Folder, result)
+}
+
+// 응답을 받은 요청의 검증 결과를 Assertions에 추가
+// 상태 코드를 지정한 검증이 없으면 요청의 기대 상태 코드(expected, nil이면 2xx)를 함께 검사한다
+func (s *assertionSet) evaluate(result *TestResult, expected *statusExpectation) {
+	var checks []*requestCheck
+	customStatus := false
+	if s != nil {
+		for i := range s.checks {
+			if s.checks[i].appliesTo(result) {
+				checks = append(checks, &s.checks[i])
+				customStatus = customStatus || s.checks[i].Status != nil
+			}
+		}
+	}
+
+	if !customStatus && expected != nil {
+		result.Assertions = append(result.Assertions, expected.assert(result.StatusCode))
+	} else if !customStatus {
+		passed := result.StatusCode >= 200 && result.StatusCode < 300
+		assertion := AssertionResult{Name: "상태 코드 2xx", Passed: passed}
+		if !passed {
+			assertion.Message = fmt.Sprintf("HTTP %d: %s", result.StatusCode, statusLine(result.StatusCode))
+		}
+		result.Assertions = append(result.Assertions, assertion)
+	}
+
+	var document interface{}
+	var documentErr error
+	parsed := false
+	parseBody := func() (interface{}, error) {
+		if !parsed {
+			documentErr = json.Unmarshal([]byte(result.ResponseBody), &document)
+			parsed = true
+		}
+		return document, documentErr
+	}
+
+	for _, check := range checks {
+		result.Assertions = append(result.Assertions, check.evaluate(result, parseBody)...)
+	}
+}
+
+// 검증 결과로 성공 여부와 오류 메시지 결정 (실패한 검증 메시지를 "; "로 연결)
+func settleAssertions(result *TestResult) {
+	result.Success = true
+	var messages []string
+	for _, assertion := range result.Assertions {
+		if !assertion.Passed {
+			result.Success = false
+			messages = append(messages, assertion.Message)
+		}
+	}
+	result.ErrorMessage = strings.Join(messages, "; ")
+}
+
+func (c *requestCheck) evaluate(result *TestResult, parseBody func() (interface{}, error)) []AssertionResult {
+	var assertions []AssertionResult
+
+	if c.Status != nil {
+		assertions = append(assertions, c.Status.assert(result.StatusCode))
+	}
+
+	for _, name := range sortedKeys(c.Headers) {
+		expected := c.Headers[name]
+		actual := http.Header(result.ResponseHeaders).Get(name)
+		_, present := http.Header(result.ResponseHeaders)[http.CanonicalHeaderKey(name)]
+
+		if re, ok := c.headerPatterns[name]; ok {
+			assertion := AssertionResult{Name: fmt.Sprintf("헤더 %s =~ %s", name, expected), Passed: present && re.MatchString(actual)}
+			if !assertion.Passed {
+				assertion.Message = fmt.Sprintf("헤더 %s 값 %q이(가) %s와 일치하지 않음", name, actual, expected)
+			}
+			assertions = append(assertions, assertion)
+			continue
+		}
+
+		assertion := AssertionResult{Name: fmt.Sprintf("헤더 %s = %s", name, expected), Passed: present && actual == expected}
+		if !present {
+			assertion.Message = fmt.Sprintf("헤더 %s 없음", name)
+		} else if !assertion.Passed {
+			assertion.Message = fmt.Sprintf("헤더 %s: 기대 %q, 실제 %q", name, expected, actual)
+		}
+		assertions = append(assertions, assertion)
+	}
+
+	for _, check := range c.JSON {
+		assertions = append(assertions, check.evaluate(parseBody)...)
+	}
+
+	if c.Body != nil {
+		if c.Body.Contains != "" {
+			assertion := AssertionResult{
+				Name:   fmt.Sprintf("본문에 %q 포함", c.Body.Contains),
+				Passed: strings.Contains(result.ResponseBody, c.Body.Contains),
+			}
+			if !assertion.Passed {
+				assertion.Message = fmt.Sprintf("본문에 %q이(가) 없음", c.Body.Contains)
+			}
+			assertions = append(assertions, assertion)
+		}
+		if c.Body.pattern != nil {
+			assertion := AssertionResult{
+				Name:   fmt.Sprintf("본문 =~ /%s/", c.Body.Matches),
+				Passed: c.Body.pattern.MatchString(result.ResponseBody),
+			}
+			if !assertion.Passed {
+				assertion.Message = fmt.Sprintf("본문이 /%s/와 일치하지 않음", c.Body.Matches)
+			}
+			assertions = append(assertions, assertion)
+		}
+	}
+
+	if c.MaxResponseTime != nil {
+		limit := time.Duration(*c.MaxResponseTime)
+		assertion := AssertionResult{
+			Name:   fmt.Sprintf("응답 시간 ≤ %s", formatDuration(limit)),
+			Passed: result.ResponseTime <= limit,
+		}
+		if !assertion.Passed {
+			assertion.Message = fmt.Sprintf("응답 시간 %s이(가) 제한 %s 초과", formatDuration(result.ResponseTime), formatDuration(limit))
+		}
+		assertions = append(assertions, assertion)
+	}
+
+	if c.schema != nil {
+		assertions = append(assertions, validateSchema(c.schema, filepath.Base(c.Schema), result))
+	}
+
+	return assertions
+}
+
+func (c *jsonCheck) evaluate(parseBody func() (interface{}, error)) []AssertionResult {
+	var assertions []AssertionResult
+	document, err := parseBody()
+
+	fail := func(name, message string) {
+		assertions = append(assertions, AssertionResult{Name: name, Message: message})
+	}
+
+	if c.Exists != nil {
+		name := fmt.Sprintf("JSON %s 존재", c.Path)
+		if !*c.Exists {
+			name = fmt.Sprintf("JSON %s 없음", c.Path)
+		}
+		if err != nil {
+			fail(name, fmt.Sprintf("응답 본문이 JSON이 아님: %v", err))
+		} else {
+			_, found := c.path.lookup(document)
+			assertion := AssertionResult{Name: name, Passed: found == *c.Exists}
+			if !assertion.Passed && found {
+				assertion.Message = fmt.Sprintf("%s 값이 있음", c.Path)
+			} else if !assertion.Passed {
+				assertion.Message = fmt.Sprintf("%s 값이 없음", c.Path)
+			}
+			assertions = append(assertions, assertion)
+		}
+	}
+
+	if c.Type != "" {
+		name := fmt.Sprintf("JSON %s 타입 %s", c.Path, c.Type)
+		value, found := c.path.lookup(document)
+		switch {
+		case err != nil:
+			fail(name, fmt.Sprintf("응답 본문이 JSON이 아님: %v", err))
+		case !found:
+			fail(name, fmt.Sprintf("%s 값이 없음", c.Path))
+		default:
+			actual := jsonTypeName(value)
+			passed := actual == c.Type || (c.Type == "number" && actual == "integer")
+			assertion := AssertionResult{Name: name, Passed: passed}
+			if !passed {
+				assertion.Message = fmt.Sprintf("%s 타입: 기대 %s, 실제 %s", c.Path, c.Type, actual)
+			}
+			assertions = append(assertions, assertion)
+		}
+	}
+
+	if c.hasEquals {
+		expected := normalizeJSONValue(c.Equals)
+		name := fmt.Sprintf("JSON %s = %s", c.Path, jsonText(expected))
+		value, found := c.path.lookup(document)
+		switch {
+		case err != nil:
+			fail(name, fmt.Sprintf("응답 본문이 JSON이 아님: %v", err))
+		case !found:
+			fail(name, fmt.Sprintf("%s 값이 없음", c.Path))
+		default:
+			passed := reflect.DeepEqual(expected, value)
+			assertion := AssertionResult{Name: name, Passed: passed}
+			if !passed {
+				assertion.Message = fmt.Sprintf("%s: 기대 %s, 실제 %s", c.Path, jsonText(expected), jsonText(value))
+			}
+			assertions = append(assertions, assertion)
+		}
+	}
+
+	return assertions
+}
+
+// YAML에서 읽은 값을 encoding/json이 만드는 형태(float64, map[string]interface{})로 변환
+func normalizeJSONValue(value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return value
+	}
+	return normalized
+}
+
+func jsonText(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// 기대 상태 코드 (200, [200, 201], "2xx", "200-204")
+type statusExpectation struct {
+	label  string
+	ranges [][2]int // 포함 범위 (단일 코드는 시작과 끝이 같음)
+}
+
+func (s *statusExpectation) UnmarshalYAML(node *yaml.Node) error {
+	var values []string
+	switch node.Kind {
+	case yaml.ScalarNode:
+		values = []string{node.Value}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			values = append(values, item.Value)
+		}
+	default:
+		return fmt.Errorf("status는 숫자, 문자열 또는 목록이어야 합니다 (줄 %d)", node.Line)
+	}
+
+	expectation, err := parseStatusExpectation(values)
+	if err != nil {
+		return fmt.Errorf("줄 %d: %v", node.Line, err)
+	}
+	*s = *expectation
+	return nil
+}
+
+// 상태 코드 표현 목록 해석 (200, 2xx, 200-204)
+func parseStatusExpectation(values []string) (*statusExpectation, error) {
+	s := &statusExpectation{label: strings.Join(values, ", ")}
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		lower := strings.ToLower(value)
+
+		switch {
+		case len(lower) == 3 && strings.HasSuffix(lower, "xx") && lower[0] >= '1' && lower[0] <= '5':
+			base := int(lower[0]-'0') * 100
+			s.ranges = append(s.ranges, [2]int{base, base + 99})
+		case strings.Contains(value, "-"):
+			parts := strings.SplitN(value, "-", 2)
+			from, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
+			to, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
+			if err1 != nil || err2 != nil || from > to {
+				return nil, fmt.Errorf("상태 코드 범위 형식 오류: %s", value)
+			}
+			s.ranges = append(s.ranges, [2]int{from, to})
+		default:
+			code, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("상태 코드 형식 오류: %s", value)
+			}
+			s.ranges = append(s.ranges, [2]int{code, code})
+		}
+	}
+	return s, nil
+}
+
+func (s *statusExpectation) matches(code int) bool {
+	for _, r := range s.ranges {
+		if code >= r[0] && code <= r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *statusExpectation) assert(code int) AssertionResult {
+	assertion := AssertionResult{Name: "상태 코드 " + s.label, Passed: s.matches(code)}
+	if !assertion.Passed {
+		assertion.Message = fmt.Sprintf("HTTP %d: 기대 상태 코드 %s", code, s.label)
+	}
+	return assertion
+}
+
+// 시간 값 ("500ms", "1.5s" 또는 밀리초 숫자)
+type durationValue time.Duration
+
+func (d *durationValue) UnmarshalYAML(node *yaml.Node) error {
+	parsed, err := parseDurationValue(node.Value)
+	if err != nil {
+		return fmt.Errorf("줄 %d: %v", node.Line, err)
+	}
+	*d = parsed
+	return nil
+}
+
+func parseDurationValue(value string) (durationValue, error) {
+	if ms, err := strconv.ParseFloat(value, 64); err == nil {
+		return durationValue(ms * float64(time.Millisecond)), nil
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("시간 형식 오류: %s (예: 500ms, 2s)", value)
+	}
+	return durationValue(parsed), nil
+}
+
+// /.../ 형태의 값이면 안쪽 정규식 반환
+func slashPattern(value string) (string, bool) {
+	if len(value) >= 2 && strings.HasPrefix(value, "/") && strings.HasSuffix(value, "/") {
+		return value[1 : len(value)-1], true
+	}
+	return "", false
+}
+
+// 상태 줄 문구 (예: "404 Not Found")
+func statusLine(code int) string {
+	return fmt.Sprintf("%d %s", code, http.StatusText(code))
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
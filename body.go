@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// 바이너리 본문 기록 방식 (-binary-bodies)
+const (
+	binaryBodiesHash   = "hash"   // 크기와 SHA-256만 기록
+	binaryBodiesBase64 = "base64" // base64로 인코딩해서 기록
+)
+
+// 리포트에 기록할 응답 본문 범위 (-max-body-capture, -binary-bodies, -no-bodies)
+// 검증은 항상 전체 본문으로 한 뒤 결과에 남길 본문만 줄인다
+// 기본값은 텍스트 본문 전체, 바이너리 본문은 크기와 해시
+type bodyCapture struct {
+	limit  int64 // 기록할 최대 바이트 수 (0이면 제한 없음)
+	base64 bool  // true이면 바이너리 본문을 base64로 기록
+	omit   bool  // true이면 본문을 기록하지 않고 크기만 남김
+}
+
+func newBodyCapture(maxCapture, binaryBodies string, omit bool) (bodyCapture, error) {
+	capture := bodyCapture{omit: omit}
+	if maxCapture != "" {
+		limit, err := parseByteSize(maxCapture)
+		if err != nil {
+			return capture, fmt.Errorf("잘못된 -max-body-capture 값: %v", err)
+		}
+		capture.limit = limit
+	}
+	switch binaryBodies {
+	case binaryBodiesHash:
+	case binaryBodiesBase64:
+		capture.base64 = true
+	default:
+		return capture, fmt.Errorf("지원하지 않는 -binary-bodies 값: %s (hash, base64 중 선택)", binaryBodies)
+	}
+	return capture, nil
+}
+
+// 검증이 끝난 결과의 응답 본문을 기록 범위에 맞게 정리
+// 크기와 해시는 실제 응답 본문 기준이고, mask는 기록할 텍스트 본문에만 적용한다 (nil이면 그대로)
+func (c bodyCapture) apply(result *TestResult, body []byte, mask func(body []byte) []byte) {
+	result.BodySize = len(body)
+	result.ResponseBody = ""
+	if len(body) == 0 {
+		return
+	}
+	if c.omit {
+		result.BodyOmitted = true
+		return
+	}
+
+	if isBinaryBody(http.Header(result.ResponseHeaders).Get("Content-Type"), body) {
+		result.BodyBinary = true
+		sum := sha256.Sum256(body)
+		result.BodySHA256 = hex.EncodeToString(sum[:])
+		if !c.base64 {
+			return
+		}
+		if c.limit > 0 && int64(len(body)) > c.limit {
+			body = body[:c.limit]
+			result.BodyTruncated = true
+		}
+		result.ResponseBody = base64.StdEncoding.EncodeToString(body)
+		result.BodyEncoding = binaryBodiesBase64
+		return
+	}
+
+	// 가린 본문은 길이가 달라질 수 있으므로 가린 뒤에 자름 (잘린 JSON은 JSONPath 규칙을 적용할 수 없음)
+	size := len(body)
+	if mask != nil {
+		body = mask(body)
+	}
+	if c.limit <= 0 || int64(len(body)) <= c.limit {
+		result.ResponseBody = string(body)
+		return
+	}
+	// 글자 중간에서 자르지 않도록 UTF-8 문자 경계까지 물러남
+	cut := int(c.limit)
+	for cut > 0 && !utf8.RuneStart(body[cut]) {
+		cut--
+	}
+	result.ResponseBody = string(body[:cut]) + fmt.Sprintf("\n…[잘림: 전체 %s 중 %s]", formatBytes(size), formatBytes(cut))
+	result.BodyTruncated = true
+}
+
+// 텍스트로 기록할 수 없는 본문인지 (Content-Type 우선, 모르면 내용으로 판단)
+func isBinaryBody(contentType string, body []byte) bool {
+	media := mediaType(contentType)
+	if binaryMediaType(media) || !utf8.Valid(body) {
+		return true
+	}
+	return !textMediaType(media) && bytes.IndexByte(body, 0) >= 0
+}
+
+func binaryMediaType(media string) bool {
+	for _, prefix := range []string{"image/", "audio/", "video/", "font/"} {
+		if strings.HasPrefix(media, prefix) {
+			return !strings.HasSuffix(media, "+xml") // image/svg+xml은 텍스트
+		}
+	}
+	switch media {
+	case "application/octet-stream", "application/pdf", "application/zip", "application/gzip",
+		"application/x-gzip", "application/x-tar", "application/x-protobuf", "application/protobuf",
+		"application/vnd.ms-excel", "application/msword", "application/wasm":
+		return true
+	}
+	return strings.HasPrefix(media, "application/vnd.openxmlformats-")
+}
+
+func textMediaType(media string) bool {
+	if strings.HasPrefix(media, "text/") || strings.HasSuffix(media, "+json") || strings.HasSuffix(media, "+xml") {
+		return true
+	}
+	switch media {
+	case "application/json", "application/xml", "application/javascript", "application/x-www-form-urlencoded",
+		"application/yaml", "application/x-yaml", "application/graphql", "application/x-ndjson":
+		return true
+	}
+	return false
+}
+
+// 바이트 크기 해석 (예: 65536, 64KB, 1.5MB, 단위는 1024 배수)
+func parseByteSize(value string) (int64, error) {
+	text := strings.ToUpper(strings.TrimSpace(value))
+	multiplier := int64(1)
+	for _, unit := range []struct {
+		suffix string
+		size   int64
+	}{{"GB", 1 << 30}, {"MB", 1 << 20}, {"KB", 1 << 10}, {"G", 1 << 30}, {"M", 1 << 20}, {"K", 1 << 10}, {"B", 1}} {
+		if strings.HasSuffix(text, unit.suffix) {
+			text, multiplier = strings.TrimSpace(strings.TrimSuffix(text, unit.suffix)), unit.size
+			break
+		}
+	}
+	number, err := strconv.ParseFloat(text, 64)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("크기 형식 오류: %s (예: 64KB, 1MB)", value)
+	}
+	return int64(number * float64(multiplier)), nil
+}
+
+// 사람이 읽기 좋은 바이트 크기 표기 (예: 512B, 1.5KB, 12.3MB)
+func formatBytes(size int) string {
+	switch {
+	case size < 1<<10:
+		return fmt.Sprintf("%dB", size)
+	case size < 1<<20:
+		return fmt.Sprintf("%.1fKB", float64(size)/(1<<10))
+	case size < 1<<30:
+		return fmt.Sprintf("%.1fMB", float64(size)/(1<<20))
+	default:
+		return fmt.Sprintf("%.1fGB", float64(size)/(1<<30))
+	}
+}
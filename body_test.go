@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+func TestBodyCaptureApply(t *testing.T) {
+	mask := func(body []byte) []byte {
+		return bytes.ReplaceAll(body, []byte("secret-token-value"), []byte(maskedValue))
+	}
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 32)...)
+	pngSum := sha256.Sum256(png)
+
+	tests := []struct {
+		name        string
+		capture     bodyCapture
+		contentType string
+		body        []byte
+		want        TestResult
+	}{
+		{"masked text keeps real size", bodyCapture{}, "application/json",
+			[]byte(`{"token":"secret-token-value"}`),
+			TestResult{BodySize: 30, ResponseBody: `{"token":"****"}`}},
+		{"truncated after masking", bodyCapture{limit: 8}, "text/plain",
+			[]byte("secret-token-value and more"),
+			TestResult{BodySize: 27, ResponseBody: "**** and\n…[잘림: 전체 27B 중 8B]", BodyTruncated: true}},
+		{"truncated at rune boundary", bodyCapture{limit: 4}, "text/plain",
+			[]byte("가나다"),
+			TestResult{BodySize: 9, ResponseBody: "가\n…[잘림: 전체 9B 중 3B]", BodyTruncated: true}},
+		{"binary hashed from raw body", bodyCapture{}, "image/png", png,
+			TestResult{BodySize: len(png), BodyBinary: true, BodySHA256: hex.EncodeToString(pngSum[:])}},
+		{"omitted", bodyCapture{omit: true}, "text/plain", []byte("secret-token-value"),
+			TestResult{BodySize: 18, BodyOmitted: true}},
+	}
+	for _, tt := range tests {
+		result := TestResult{ResponseHeaders: map[string][]string{"Content-Type": {tt.contentType}}, ResponseBody: string(tt.body)}
+		tt.capture.apply(&result, tt.body, mask)
+		result.ResponseHeaders = nil
+		if result.BodySize != tt.want.BodySize || result.ResponseBody != tt.want.ResponseBody ||
+			result.BodyTruncated != tt.want.BodyTruncated || result.BodyOmitted != tt.want.BodyOmitted ||
+			result.BodyBinary != tt.want.BodyBinary || result.BodySHA256 != tt.want.BodySHA256 {
+			t.Errorf("%s:\n got  %+v\n want %+v", tt.name, result, tt.want)
+		}
+	}
+}
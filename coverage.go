@@ -0,0 +1,209 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+)
+
+func init() {
+	registerReportFormat("coverage", func() ReportGenerator { return coverageReport{} })
+}
+
+// OpenAPI 명세 대비 API 커버리지 (-openapi 사용 시)
+type APICoverage struct {
+	Operations        []OperationCoverage `json:"operations"`
+	OperationsTotal   int                 `json:"operations_total"`
+	OperationsCovered int                 `json:"operations_covered"`
+	OperationPercent  float64             `json:"operation_percent"`
+	ResponsesTotal    int                 `json:"responses_total"`
+	ResponsesCovered  int                 `json:"responses_covered"`
+	ResponsePercent   float64             `json:"response_percent"`
+	Unmatched         []string            `json:"unmatched,omitempty"` // 명세에 없는 요청 (예: "DELETE /users")
+}
+
+// 오퍼레이션 하나의 커버리지 (Requests는 응답을 받은 요청 수)
+type OperationCoverage struct {
+	Method      string             `json:"method"`
+	Path        string             `json:"path"`
+	OperationID string             `json:"operation_id,omitempty"`
+	Requests    int                `json:"requests"`
+	Responses   []ResponseCoverage `json:"responses"`
+	Undeclared  []int              `json:"undeclared_statuses,omitempty"` // 명세에 없는 상태 코드로 응답한 경우
+}
+
+// 명세에 선언된 응답 하나 (Code는 "200", "4XX", "default" 등 명세의 키)
+type ResponseCoverage struct {
+	Code     string `json:"code"`
+	Requests int    `json:"requests"`
+}
+
+func (o OperationCoverage) Covered() bool {
+	return o.Requests > 0
+}
+
+// 요청 결과로 명세의 오퍼레이션/응답별 실행 횟수 집계 (명세가 nil이면 nil)
+// 건너뛰었거나 응답을 받지 못한 요청은 세지 않는다
+func (s *openAPISpec) coverage(results []TestResult) *APICoverage {
+	if s == nil {
+		return nil
+	}
+
+	coverage := &APICoverage{}
+	index := make(map[string]int)
+	for i, operation := range s.operations {
+		entry := OperationCoverage{Method: operation.Method, Path: operation.Path}
+		entry.OperationID, _ = operation.node["operationId"].(string)
+		responses, _ := operation.node["responses"].(map[string]interface{})
+		for _, code := range sortedMapKeys(responses) {
+			entry.Responses = append(entry.Responses, ResponseCoverage{Code: code})
+		}
+		coverage.Operations = append(coverage.Operations, entry)
+		index[operation.Method+" "+operation.Path] = i
+	}
+
+	for _, result := range results {
+		if result.Skipped || result.StatusCode == 0 {
+			continue
+		}
+		i, ok := index[result.Operation]
+		if !ok {
+			if result.Operation == "" && !containsString(coverage.Unmatched, result.Method+" "+requestPath(result.URL)) {
+				coverage.Unmatched = append(coverage.Unmatched, result.Method+" "+requestPath(result.URL))
+			}
+			continue
+		}
+		entry := &coverage.Operations[i]
+		entry.Requests++
+
+		responses, _ := s.operations[i].node["responses"].(map[string]interface{})
+		key := responseKey(responses, result.StatusCode)
+		if key == "" {
+			if !containsInt(entry.Undeclared, result.StatusCode) {
+				entry.Undeclared = append(entry.Undeclared, result.StatusCode)
+			}
+			continue
+		}
+		for j := range entry.Responses {
+			if entry.Responses[j].Code == key {
+				entry.Responses[j].Requests++
+			}
+		}
+	}
+
+	coverage.tally()
+	return coverage
+}
+
+// 여러 컬렉션의 커버리지 합산 (같은 명세로 집계한 것이어야 함, 하나도 없으면 nil)
+func mergeCoverage(summaries []*TestSummary) *APICoverage {
+	var merged *APICoverage
+	for _, summary := range summaries {
+		if summary.Coverage == nil {
+			continue
+		}
+		if merged == nil {
+			merged = &APICoverage{}
+			for _, operation := range summary.Coverage.Operations {
+				operation.Requests = 0
+				operation.Undeclared = nil
+				operation.Responses = append([]ResponseCoverage{}, operation.Responses...)
+				for j := range operation.Responses {
+					operation.Responses[j].Requests = 0
+				}
+				merged.Operations = append(merged.Operations, operation)
+			}
+		}
+
+		for i, operation := range summary.Coverage.Operations {
+			if i >= len(merged.Operations) {
+				break
+			}
+			target := &merged.Operations[i]
+			target.Requests += operation.Requests
+			for j, response := range operation.Responses {
+				target.Responses[j].Requests += response.Requests
+			}
+			for _, code := range operation.Undeclared {
+				if !containsInt(target.Undeclared, code) {
+					target.Undeclared = append(target.Undeclared, code)
+				}
+			}
+		}
+		for _, unmatched := range summary.Coverage.Unmatched {
+			if !containsString(merged.Unmatched, unmatched) {
+				merged.Unmatched = append(merged.Unmatched, unmatched)
+			}
+		}
+	}
+
+	if merged != nil {
+		merged.tally()
+	}
+	return merged
+}
+
+// 합계와 비율 계산
+func (c *APICoverage) tally() {
+	c.OperationsTotal, c.OperationsCovered = len(c.Operations), 0
+	c.ResponsesTotal, c.ResponsesCovered = 0, 0
+	for _, operation := range c.Operations {
+		if operation.Covered() {
+			c.OperationsCovered++
+		}
+		c.ResponsesTotal += len(operation.Responses)
+		for _, response := range operation.Responses {
+			if response.Requests > 0 {
+				c.ResponsesCovered++
+			}
+		}
+	}
+	c.OperationPercent = percent(c.OperationsCovered, c.OperationsTotal)
+	c.ResponsePercent = percent(c.ResponsesCovered, c.ResponsesTotal)
+}
+
+// 상태 코드에 해당하는 응답 정의의 키 (코드 → 2XX 범위 → default 순, 없으면 빈 문자열)
+func responseKey(responses map[string]interface{}, status int) string {
+	code := strconv.Itoa(status)
+	for _, candidate := range []string{code, code[:1] + "XX", code[:1] + "xx", "default"} {
+		if _, ok := responses[candidate]; ok {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// URL의 경로 부분 (해석할 수 없으면 그대로)
+func requestPath(raw string) string {
+	if parsed, err := url.Parse(raw); err == nil {
+		return parsed.Path
+	}
+	return raw
+}
+
+func containsInt(list []int, value int) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// 단독 커버리지 리포트 (-format coverage, 모든 컬렉션을 합친 결과를 JSON으로 출력)
+type coverageReport struct{}
+
+func (coverageReport) Extension() string { return "json" }
+
+func (coverageReport) Generate(summaries []*TestSummary) (string, error) {
+	coverage := mergeCoverage(summaries)
+	if coverage == nil {
+		return "", errors.New("커버리지 리포트에는 -openapi 명세가 필요합니다")
+	}
+	data, err := json.MarshalIndent(coverage, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
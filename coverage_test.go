@@ -0,0 +1,113 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOpenAPICoverage(t *testing.T) {
+	spec := loadTestOpenAPISpec(t)
+	results := []TestResult{
+		{Operation: "GET /users", StatusCode: 200},
+		{Operation: "GET /users/{id}", StatusCode: 404},
+		{Operation: "GET /users/{id}", StatusCode: 500},
+		{Operation: "GET /users/{id}", StatusCode: 500},
+		{Operation: "GET /users/{id}/posts/{postId}", StatusCode: 503},
+		{Operation: "POST /users", Skipped: true},
+		{Operation: "POST /users", ErrorMessage: "connection refused"},
+		{Method: "DELETE", URL: "https://api.example.com/v1/orders?id=1", StatusCode: 204},
+		{Method: "DELETE", URL: "https://api.example.com/v1/orders", StatusCode: 204},
+	}
+
+	coverage := spec.coverage(results)
+
+	requests := map[string]int{}
+	responses := map[string]int{}
+	for _, operation := range coverage.Operations {
+		requests[operation.Method+" "+operation.Path] = operation.Requests
+		for _, response := range operation.Responses {
+			responses[operation.Method+" "+operation.Path+" "+response.Code] = response.Requests
+		}
+	}
+	wantRequests := map[string]int{
+		"GET /users": 1, "POST /users": 0, "GET /users/me": 0, "GET /users/{id}": 3, "GET /users/{id}/posts/{postId}": 1,
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("requests per operation = %v, want %v", requests, wantRequests)
+	}
+	wantResponses := map[string]int{
+		"GET /users 200": 1, "POST /users 201": 0, "GET /users/me 200": 0,
+		"GET /users/{id} 200": 0, "GET /users/{id} 4XX": 1, "GET /users/{id}/posts/{postId} default": 1,
+	}
+	if !reflect.DeepEqual(responses, wantResponses) {
+		t.Errorf("requests per response = %v, want %v", responses, wantResponses)
+	}
+	if got := coverage.Operations[3].Undeclared; !reflect.DeepEqual(got, []int{500}) {
+		t.Errorf("undeclared statuses = %v, want [500]", got)
+	}
+	if !reflect.DeepEqual(coverage.Unmatched, []string{"DELETE /v1/orders"}) {
+		t.Errorf("unmatched = %q", coverage.Unmatched)
+	}
+
+	totals := [6]float64{
+		float64(coverage.OperationsTotal), float64(coverage.OperationsCovered), coverage.OperationPercent,
+		float64(coverage.ResponsesTotal), float64(coverage.ResponsesCovered), coverage.ResponsePercent,
+	}
+	if want := [6]float64{5, 3, 60, 6, 3, 50}; totals != want {
+		t.Errorf("totals = %v, want %v", totals, want)
+	}
+
+	var noSpec *openAPISpec
+	if noSpec.coverage(results) != nil {
+		t.Error("coverage without a spec must be nil")
+	}
+}
+
+func TestMergeCoverage(t *testing.T) {
+	spec := loadTestOpenAPISpec(t)
+	summaries := []*TestSummary{
+		{Coverage: spec.coverage([]TestResult{{Operation: "GET /users", StatusCode: 200}, {Operation: "GET /users/{id}", StatusCode: 500}})},
+		{},
+		{Coverage: spec.coverage([]TestResult{
+			{Operation: "GET /users", StatusCode: 200},
+			{Operation: "POST /users", StatusCode: 201},
+			{Operation: "GET /users/{id}", StatusCode: 500},
+			{Method: "GET", URL: "https://x/health", StatusCode: 200},
+		})},
+	}
+
+	merged := mergeCoverage(summaries)
+	if merged.Operations[0].Requests != 2 || merged.Operations[0].Responses[0].Requests != 2 {
+		t.Errorf("GET /users = %+v, want 2 requests", merged.Operations[0])
+	}
+	if !reflect.DeepEqual(merged.Operations[3].Undeclared, []int{500}) || !reflect.DeepEqual(merged.Unmatched, []string{"GET /health"}) {
+		t.Errorf("undeclared = %v, unmatched = %q", merged.Operations[3].Undeclared, merged.Unmatched)
+	}
+	if merged.OperationsCovered != 3 || merged.ResponsesCovered != 2 {
+		t.Errorf("covered operations = %d, responses = %d; want 3, 2", merged.OperationsCovered, merged.ResponsesCovered)
+	}
+	// 합산은 컬렉션별 결과를 바꾸지 않아야 한다
+	if summaries[0].Coverage.Operations[0].Requests != 1 {
+		t.Errorf("merge modified the first summary: %+v", summaries[0].Coverage.Operations[0])
+	}
+
+	if mergeCoverage([]*TestSummary{{}}) != nil {
+		t.Error("merge without coverage must be nil")
+	}
+	if _, err := (coverageReport{}).Generate([]*TestSummary{{}}); err == nil {
+		t.Error("coverage report without -openapi must fail")
+	}
+}
+
+func TestResponseKey(t *testing.T) {
+	responses := map[string]interface{}{"200": nil, "4XX": nil, "5xx": nil, "default": nil}
+	tests := map[int]string{200: "200", 404: "4XX", 503: "5xx", 302: "default"}
+	for status, want := range tests {
+		if got := responseKey(responses, status); got != want {
+			t.Errorf("responseKey(%d) = %q, want %q", status, got, want)
+		}
+	}
+	if got := responseKey(map[string]interface{}{"200": nil}, 201); got != "" {
+		t.Errorf("responseKey without match = %q", got)
+	}
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// CSV 한 행에 대응하는 값 (요청 단위 행이면 assertion은 nil)
+type csvRow struct {
+	summary   *TestSummary
+	result    TestResult
+	assertion *AssertionResult
+}
+
+// CSV 열 이름별 값 추출 함수 (이름은 헤더에 그대로 쓰이며 -csv-columns에서는 대소문자 구분 없이 지정)
+var csvColumnValues = map[string]func(row csvRow) string{
+	"Collection": func(row csvRow) string { return row.summary.CollectionName },
+	"FilePath":   func(row csvRow) string { return row.summary.FilePath },
+	"Folder":     func(row csvRow) string { return row.result.Folder },
+	"TestName":   func(row csvRow) string { return row.result.Name },
+	"Method":     func(row csvRow) string { return row.result.Method },
+	"URL":        func(row csvRow) string { return row.result.URL },
+	"StatusCode": func(row csvRow) string { return fmt.Sprintf("%d", row.result.StatusCode) },
+	"Success": func(row csvRow) string {
+		switch {
+		case row.result.Skipped:
+			return "skipped"
+		case row.result.Success:
+			return "true"
+		default:
+			return "false"
+		}
+	},
+	"ResponseTime": func(row csvRow) string { return fmt.Sprintf("%.3f", row.result.ResponseTime.Seconds()) },
+	"ErrorMessage": func(row csvRow) string {
+		if row.result.Skipped {
+			return row.result.SkipReason
+		}
+		return row.result.ErrorMessage
+	},
+	"Timestamp": func(row csvRow) string { return row.result.Timestamp.Format(time.RFC3339) },
+	"DNS":       func(row csvRow) string { return csvTiming(row, func(t *RequestTimings) time.Duration { return t.DNS }) },
+	"Connect": func(row csvRow) string {
+		return csvTiming(row, func(t *RequestTimings) time.Duration { return t.Connect })
+	},
+	"TLS": func(row csvRow) string { return csvTiming(row, func(t *RequestTimings) time.Duration { return t.TLS }) },
+	"TTFB": func(row csvRow) string {
+		return csvTiming(row, func(t *RequestTimings) time.Duration { return t.TTFB })
+	},
+	"Download": func(row csvRow) string {
+		return csvTiming(row, func(t *RequestTimings) time.Duration { return t.Download })
+	},
+	"ConnectionReused": func(row csvRow) string {
+		if row.result.Timings == nil {
+			return ""
+		}
+		return fmt.Sprintf("%t", row.result.Timings.ConnectionReused)
+	},
+	"AssertionsPassed": func(row csvRow) string { return fmt.Sprintf("%d", countAssertions(row.result, true)) },
+	"AssertionsFailed": func(row csvRow) string { return fmt.Sprintf("%d", countAssertions(row.result, false)) },
+	"Assertion": func(row csvRow) string {
+		if row.assertion == nil {
+			return ""
+		}
+		return row.assertion.Name
+	},
+	"AssertionPassed": func(row csvRow) string {
+		if row.assertion == nil {
+			return ""
+		}
+		return fmt.Sprintf("%t", row.assertion.Passed)
+	},
+	"AssertionMessage": func(row csvRow) string {
+		if row.assertion == nil {
+			return ""
+		}
+		return row.assertion.Message
+	},
+}
+
+// 열 목록 표시 순서 (도움말/오류 메시지용)
+var csvColumnNames = []string{
+	"Collection", "FilePath", "Folder", "TestName", "Method", "URL", "StatusCode", "Success",
+	"ResponseTime", "ErrorMessage", "Timestamp", "DNS", "Connect", "TLS", "TTFB", "Download", "ConnectionReused",
+	"AssertionsPassed", "AssertionsFailed",
+	"Assertion", "AssertionPassed", "AssertionMessage",
+}
+
+// 기본 열 (기존 CSV 형식과 동일)
+var defaultCSVColumns = []string{
+	"Collection", "FilePath", "TestName", "Method", "URL", "StatusCode", "Success", "ResponseTime", "ErrorMessage",
+}
+
+// 검증 단위 행(-csv-rows assertion)일 때 기본 열 뒤에 추가되는 열
+var assertionCSVColumns = []string{"Assertion", "AssertionPassed", "AssertionMessage"}
+
+// CSV 행 단위
+const (
+	csvRowsRequest   = "request"
+	csvRowsAssertion = "assertion"
+	csvRowsLatency   = "latency" // 컬렉션/폴더/요청별 응답 시간 통계 (열 고정)
+)
+
+// 응답 시간 통계 행의 열 (시간 단위: 초)
+var latencyCSVColumns = []string{
+	"Collection", "Scope", "Name", "Count", "Min", "P50", "P90", "P95", "P99", "Max", "Mean", "StdDev",
+}
+
+type csvOptions struct {
+	columns   []string
+	delimiter rune
+	rows      string
+	bom       bool // UTF-8 BOM 추가 (Excel에서 한글 제대로 표시하기 위함)
+}
+
+// 컬렉션 반복 실행 기능이 없어 지원하지 않는 열 (모든 요청이 한 번만 실행됨)
+var unsupportedCSVColumns = map[string]string{
+	"iteration": "반복 실행 기능이 없어 모든 요청은 한 번만 실행됩니다",
+}
+
+// 명령줄 값으로부터 CSV 옵션 생성 (columns가 비어있으면 행 단위에 맞는 기본 열 사용)
+func newCSVOptions(columns, delimiter, rows string, bom bool) (csvOptions, error) {
+	options := csvOptions{rows: rows, bom: bom}
+
+	switch rows {
+	case csvRowsRequest:
+		options.columns = defaultCSVColumns
+	case csvRowsAssertion:
+		options.columns = append(append([]string{}, defaultCSVColumns...), assertionCSVColumns...)
+	case csvRowsLatency:
+		if columns != "" {
+			return options, fmt.Errorf("-csv-rows %s에서는 -csv-columns를 사용할 수 없습니다", csvRowsLatency)
+		}
+		options.columns = latencyCSVColumns
+	default:
+		return options, fmt.Errorf("지원하지 않는 -csv-rows 값: %s (%s, %s, %s 중 선택)", rows, csvRowsRequest, csvRowsAssertion, csvRowsLatency)
+	}
+
+	if columns != "" {
+		options.columns = nil
+		for _, name := range splitList(columns) {
+			if reason, unsupported := unsupportedCSVColumns[strings.ToLower(name)]; unsupported {
+				return options, fmt.Errorf("지원하지 않는 CSV 열: %s (%s)", name, reason)
+			}
+			column, ok := csvColumnName(name)
+			if !ok {
+				return options, fmt.Errorf("알 수 없는 CSV 열: %s (사용 가능: %s)", name, strings.Join(csvColumnNames, ", "))
+			}
+			options.columns = append(options.columns, column)
+		}
+	}
+
+	switch delimiter {
+	case "tab", `\t`:
+		options.delimiter = '\t'
+	default:
+		r, size := utf8.DecodeRuneInString(delimiter)
+		if size == 0 || size != len(delimiter) || r == '"' || r == '\r' || r == '\n' {
+			return options, fmt.Errorf("CSV 구분자는 따옴표/줄바꿈이 아닌 한 글자여야 합니다: %q", delimiter)
+		}
+		options.delimiter = r
+	}
+
+	return options, nil
+}
+
+// 대소문자 구분 없이 열 이름 찾기
+func csvColumnName(name string) (string, bool) {
+	for _, column := range csvColumnNames {
+		if strings.EqualFold(column, name) {
+			return column, true
+		}
+	}
+	return "", false
+}
+
+func init() {
+	registerReportFormat("csv", func() ReportGenerator {
+		return csvReport{options: csvOptions{columns: defaultCSVColumns, delimiter: ',', rows: csvRowsRequest, bom: true}}
+	})
+}
+
+// options는 기본값으로 생성되고 -csv-* 옵션은 configureCSVReports로 적용한다
+type csvReport struct {
+	options csvOptions
+}
+
+// -csv-* 옵션을 출력 대상 중 CSV 리포터에 적용
+func configureCSVReports(targets []reportTarget, options csvOptions) {
+	for _, target := range targets {
+		if target.reporter.format == "csv" {
+			target.reporter.generator = csvReport{options: options}
+		}
+	}
+}
+
+func (csvReport) Extension() string { return "csv" }
+
+// RFC 4180 형식의 CSV 생성 (값에 구분자, 따옴표, 줄바꿈이 있으면 따옴표로 감쌈)
+func (r csvReport) Generate(summaries []*TestSummary) (string, error) {
+	var sb strings.Builder
+	if r.options.bom {
+		sb.WriteString("\uFEFF")
+	}
+
+	w := csv.NewWriter(&sb)
+	w.Comma = r.options.delimiter
+	w.UseCRLF = true
+
+	if err := w.Write(r.options.columns); err != nil {
+		return "", err
+	}
+
+	for _, summary := range summaries {
+		for _, record := range r.recordsFor(summary) {
+			if err := w.Write(record); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// 컬렉션 하나에 대한 CSV 레코드 목록
+func (r csvReport) recordsFor(summary *TestSummary) [][]string {
+	if r.options.rows == csvRowsLatency {
+		return latencyRecords(summary)
+	}
+
+	var records [][]string
+	for _, result := range summary.Results {
+		for _, row := range r.rowsFor(summary, result) {
+			record := make([]string, len(r.options.columns))
+			for i, column := range r.options.columns {
+				record[i] = csvColumnValues[column](row)
+			}
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// 요청 하나에 대한 행 목록 (검증 단위일 때 검증 결과가 없는 요청은 한 행으로 출력)
+func (r csvReport) rowsFor(summary *TestSummary, result TestResult) []csvRow {
+	if r.options.rows != csvRowsAssertion || len(result.Assertions) == 0 {
+		return []csvRow{{summary: summary, result: result}}
+	}
+
+	rows := make([]csvRow, len(result.Assertions))
+	for i := range result.Assertions {
+		rows[i] = csvRow{summary: summary, result: result, assertion: &result.Assertions[i]}
+	}
+	return rows
+}
+
+// 컬렉션 하나의 응답 시간 통계 행 (컬렉션 전체, 폴더별, 요청별 순서)
+func latencyRecords(summary *TestSummary) [][]string {
+	latency := summary.Latency
+	if latency == nil {
+		return nil
+	}
+
+	record := func(scope, name string, stats LatencyStats) []string {
+		seconds := func(d time.Duration) string { return fmt.Sprintf("%.3f", d.Seconds()) }
+		return []string{
+			summary.CollectionName, scope, name, fmt.Sprintf("%d", stats.Count),
+			seconds(stats.Min), seconds(stats.P50), seconds(stats.P90), seconds(stats.P95),
+			seconds(stats.P99), seconds(stats.Max), seconds(stats.Mean), seconds(stats.StdDev),
+		}
+	}
+
+	records := [][]string{record("collection", summary.CollectionName, latency.Overall)}
+	for _, group := range latency.Folders {
+		records = append(records, record("folder", group.Name, group.LatencyStats))
+	}
+	for _, group := range latency.Requests {
+		records = append(records, record("request", group.Name, group.LatencyStats))
+	}
+	return records
+}
+
+// 단계별 시간 값 (초, 요청을 전송하지 않았으면 빈 값)
+func csvTiming(row csvRow, phase func(t *RequestTimings) time.Duration) string {
+	if row.result.Timings == nil {
+		return ""
+	}
+	return fmt.Sprintf("%.3f", phase(row.result.Timings).Seconds())
+}
+
+// 통과(passed=true) 또는 실패한 검증 개수
+func countAssertions(result TestResult, passed bool) int {
+	count := 0
+	for _, assertion := range result.Assertions {
+		if assertion.Passed == passed {
+			count++
+		}
+	}
+	return count
+}
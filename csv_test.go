@@ -0,0 +1,116 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func csvTestSummaries() []*TestSummary {
+	return []*TestSummary{{
+		CollectionName: "Users",
+		FilePath:       "postman/users.json",
+		Results: []TestResult{
+			{Name: "List", Folder: "a/b", Method: "GET", URL: "https://x/users?a=1,b=2", StatusCode: 200, Success: true,
+				ResponseTime: 1500 * time.Millisecond,
+				Assertions:   []AssertionResult{{Name: "상태 코드 2xx", Passed: true}, {Name: `body "name"`, Message: "line1\r\nline2"}}},
+			{Name: `Say "hi"; bye`, Method: "POST", URL: "https://x/", StatusCode: 500, ErrorMessage: "a,b\nc"},
+			{Name: "Skip", Method: "GET", Skipped: true, SkipReason: "필터"},
+		},
+	}}
+}
+
+func generateTestCSV(t *testing.T, columns, delimiter, rows string, bom bool) string {
+	t.Helper()
+	options, err := newCSVOptions(columns, delimiter, rows, bom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := csvReport{options: options}.Generate(csvTestSummaries())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+func TestCSVReportDefault(t *testing.T) {
+	got := generateTestCSV(t, "", ",", csvRowsRequest, true)
+	want := "\uFEFF" +
+		"Collection,FilePath,TestName,Method,URL,StatusCode,Success,ResponseTime,ErrorMessage\r\n" +
+		"Users,postman/users.json,List,GET,\"https://x/users?a=1,b=2\",200,true,1.500,\r\n" +
+		"Users,postman/users.json,\"Say \"\"hi\"\"; bye\",POST,https://x/,500,false,0.000,\"a,b\r\nc\"\r\n" + // UseCRLF는 값 안의 줄바꿈도 CRLF로 씀
+		"Users,postman/users.json,Skip,GET,,0,skipped,0.000,필터\r\n"
+	if got != want {
+		t.Errorf("default CSV:\n got  %q\n want %q", got, want)
+	}
+}
+
+func TestCSVReportDelimiterAndColumns(t *testing.T) {
+	tests := []struct {
+		name      string
+		columns   string
+		delimiter string
+		want      string
+	}{
+		{"semicolon", "testname, Folder,success", ";",
+			"TestName;Folder;Success\r\nList;a/b;true\r\n\"Say \"\"hi\"\"; bye\";;false\r\nSkip;;skipped\r\n"},
+		{"tab", "TestName,URL", "tab",
+			"TestName\tURL\r\nList\thttps://x/users?a=1,b=2\r\n\"Say \"\"hi\"\"; bye\"\thttps://x/\r\nSkip\t\r\n"},
+	}
+	for _, tt := range tests {
+		if got := generateTestCSV(t, tt.columns, tt.delimiter, csvRowsRequest, false); got != tt.want {
+			t.Errorf("%s:\n got  %q\n want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCSVReportAssertionRows(t *testing.T) {
+	got := generateTestCSV(t, "TestName,Assertion,AssertionPassed,AssertionMessage", ",", csvRowsAssertion, false)
+	want := "TestName,Assertion,AssertionPassed,AssertionMessage\r\n" +
+		"List,상태 코드 2xx,true,\r\n" +
+		"List,\"body \"\"name\"\"\",false,\"line1\r\nline2\"\r\n" +
+		"\"Say \"\"hi\"\"; bye\",,,\r\n" +
+		"Skip,,,\r\n"
+	if got != want {
+		t.Errorf("assertion rows:\n got  %q\n want %q", got, want)
+	}
+}
+
+func TestNewCSVOptionsErrors(t *testing.T) {
+	tests := []struct {
+		columns, delimiter, rows string
+		wantErr                  string
+	}{
+		{"Nope", ",", csvRowsRequest, "알 수 없는 CSV 열"},
+		{"TestName,Iteration", ",", csvRowsRequest, "지원하지 않는 CSV 열: Iteration"},
+		{"", ";;", csvRowsRequest, "한 글자"},
+		{"", `"`, csvRowsRequest, "한 글자"},
+		{"", ",", "response", "지원하지 않는 -csv-rows"},
+		{"TestName", ",", csvRowsLatency, "-csv-columns를 사용할 수 없습니다"},
+	}
+	for _, tt := range tests {
+		_, err := newCSVOptions(tt.columns, tt.delimiter, tt.rows, true)
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("newCSVOptions(%q, %q, %q) error = %v, want containing %q", tt.columns, tt.delimiter, tt.rows, err, tt.wantErr)
+		}
+	}
+}
+
+func TestConfigureCSVReports(t *testing.T) {
+	options, err := newCSVOptions("TestName", ";", csvRowsRequest, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	targets := []reportTarget{{reporter: NewReporter("csv")}, {reporter: NewReporter("json")}}
+	configureCSVReports(targets, options)
+
+	if got := targets[0].reporter.generator.(csvReport).options.delimiter; got != ';' {
+		t.Errorf("csv delimiter = %q, want ';'", got)
+	}
+	if _, ok := targets[1].reporter.generator.(jsonReport); !ok {
+		t.Errorf("json reporter replaced: %T", targets[1].reporter.generator)
+	}
+	if got := NewReporter("csv").generator.(csvReport).options.delimiter; got != ',' {
+		t.Errorf("new csv reporter delimiter = %q, want default ','", got)
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"net/http"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// curl 명령을 붙여넣을 셸 종류
+const (
+	shellBash       = "bash"
+	shellPowerShell = "powershell"
+)
+
+// 실행 환경에 맞는 기본 셸 (Windows는 PowerShell)
+func defaultCurlShell() string {
+	if runtime.GOOS == "windows" {
+		return shellPowerShell
+	}
+	return shellBash
+}
+
+// 실행된 요청을 복사해서 바로 실행할 수 있는 curl 명령으로 변환
+// 리포트와 같은 규칙으로 민감한 헤더, 쿼리 파라미터, secret 변수 값을 가린다 (redact가 nil이면 그대로)
+func renderCurl(req *http.Request, url, body, shell string, redact *redactor, secrets []string) string {
+	url = redact.url(url, secrets)
+	args := []string{"-X", req.Method}
+	if strings.ContainsAny(url, "{}[]") {
+		// {{변수}}나 배열 쿼리가 curl의 URL 글로빙으로 해석되지 않도록 함
+		args = append(args, "--globoff")
+	}
+	args = append(args, url)
+
+	keys := make([]string, 0, len(req.Header))
+	for key := range req.Header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range req.Header[key] {
+			args = append(args, "-H", key+": "+redact.headerValue(key, value, secrets))
+		}
+	}
+
+	if body != "" {
+		args = append(args, "--data-raw", redact.requestBody(body, req.Header.Get("Content-Type"), secrets))
+	}
+
+	if shell == shellPowerShell {
+		return powerShellCommand(args)
+	}
+	return bashCommand(args)
+}
+
+func bashCommand(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = bashQuote(arg)
+	}
+	return "curl " + strings.Join(quoted, " ")
+}
+
+// bash 작은따옴표 인용 (내부의 '는 '\”로 분리)
+func bashQuote(arg string) string {
+	if isPlainArg(arg, "-_./:=@,+%") {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// 셸에서 인용 없이 써도 되는 인자인지 확인
+func isPlainArg(arg, safe string) bool {
+	return arg != "" && strings.IndexFunc(arg, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune(safe, r))
+	}) < 0
+}
+
+// PowerShell에서는 Invoke-WebRequest 별칭 대신 curl.exe를 호출한다.
+// 네이티브 명령에 큰따옴표를 넘기는 방식이 버전마다 달라 인자에 "가 있으면
+// Legacy 모드로 고정하고 \"로 이스케이프한다 (Windows PowerShell 5.1과 7.x 모두 동작)
+func powerShellCommand(args []string) string {
+	legacy := false
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if isPlainArg(arg, "-_./:=") {
+			quoted[i] = arg
+			continue
+		}
+		if strings.Contains(arg, `"`) {
+			legacy = true
+			arg = escapeNativeQuotes(arg)
+		}
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", "''") + "'"
+	}
+
+	command := "curl.exe " + strings.Join(quoted, " ")
+	if legacy {
+		command = "$PSNativeCommandArgumentPassing = 'Legacy'; " + command
+	}
+	return command
+}
+
+// Windows 명령줄 인자 규칙에 맞게 " 앞에 \를 붙인다 (" 바로 앞의 \는 두 배로)
+func escapeNativeQuotes(arg string) string {
+	var sb strings.Builder
+	backslashes := 0
+	for _, r := range arg {
+		switch r {
+		case '\\':
+			backslashes++
+			continue
+		case '"':
+			sb.WriteString(strings.Repeat(`\`, backslashes*2+1))
+		default:
+			sb.WriteString(strings.Repeat(`\`, backslashes))
+		}
+		backslashes = 0
+		sb.WriteRune(r)
+	}
+	sb.WriteString(strings.Repeat(`\`, backslashes))
+	return sb.String()
+}
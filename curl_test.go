@@ -0,0 +1,100 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestBashQuote(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want string
+	}{
+		{"GET", "GET"},
+		{"https://api.example.com/v1/users", "https://api.example.com/v1/users"},
+		{"a=1,b=2+c%20", "a=1,b=2+c%20"},
+		{"", "''"},
+		{"Accept: application/json", "'Accept: application/json'"},
+		{"https://x/?a=1&b=2", "'https://x/?a=1&b=2'"},
+		{"it's", `'it'\''s'`},
+		{`{"name":"kim"}`, `'{"name":"kim"}'`},
+		{"$HOME `id` !x", "'$HOME `id` !x'"},
+		{"줄1\n줄2", "'줄1\n줄2'"},
+	}
+	for _, tt := range tests {
+		if got := bashQuote(tt.arg); got != tt.want {
+			t.Errorf("bashQuote(%q) = %s, want %s", tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestEscapeNativeQuotes(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want string
+	}{
+		{`plain`, `plain`},
+		{`{"a":1}`, `{\"a\":1}`},
+		{`C:\path\`, `C:\path\`},
+		{`a\"b`, `a\\\"b`},
+		{`a\\"b`, `a\\\\\"b`},
+		{`end\`, `end\`},
+	}
+	for _, tt := range tests {
+		if got := escapeNativeQuotes(tt.arg); got != tt.want {
+			t.Errorf("escapeNativeQuotes(%q) = %s, want %s", tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestPowerShellCommand(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"plain", []string{"-X", "GET", "https://x/users"}, "curl.exe -X GET https://x/users"},
+		{"single quote", []string{"-H", "X-Name: O'Brien"}, "curl.exe -H 'X-Name: O''Brien'"},
+		{"variable not expanded", []string{"$env:TOKEN"}, "curl.exe '$env:TOKEN'"},
+		{"comma quoted", []string{"a,b"}, "curl.exe 'a,b'"},
+		{"double quotes use legacy mode", []string{"--data-raw", `{"a":"b c"}`},
+			`$PSNativeCommandArgumentPassing = 'Legacy'; curl.exe --data-raw '{\"a\":\"b c\"}'`},
+	}
+	for _, tt := range tests {
+		if got := powerShellCommand(tt.args); got != tt.want {
+			t.Errorf("%s: powerShellCommand(%q)\n got  %s\n want %s", tt.name, tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestRenderCurl(t *testing.T) {
+	redact, err := newRedactor("X-Trace", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest("POST", "https://api.example.com/users", nil)
+	req.Header.Set("Authorization", "Bearer s3cr3t")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Trace", "abc")
+
+	tests := []struct {
+		name   string
+		url    string
+		shell  string
+		redact *redactor
+		want   string
+	}{
+		{"masked", "https://api.example.com/users?api_key=k1&q={{q}}", shellBash, redact,
+			`curl -X POST --globoff 'https://api.example.com/users?api_key=****&q={{q}}' -H 'Authorization: Bearer ****' -H 'Content-Type: application/json' -H 'X-Trace: ****' --data-raw '{"pw":"****"}'`},
+		{"revealed", "https://api.example.com/users", shellBash, nil,
+			`curl -X POST https://api.example.com/users -H 'Authorization: Bearer s3cr3t' -H 'Content-Type: application/json' -H 'X-Trace: abc' --data-raw '{"pw":"hunter2"}'`},
+		{"powershell", "https://api.example.com/users", shellPowerShell, nil,
+			`$PSNativeCommandArgumentPassing = 'Legacy'; curl.exe -X POST https://api.example.com/users -H 'Authorization: Bearer s3cr3t' -H 'Content-Type: application/json' -H 'X-Trace: abc' --data-raw '{\"pw\":\"hunter2\"}'`},
+	}
+	for _, tt := range tests {
+		got := renderCurl(req, tt.url, `{"pw":"hunter2"}`, tt.shell, tt.redact, []string{"hunter2"})
+		if got != tt.want {
+			t.Errorf("%s:\n got  %s\n want %s", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Postman 환경 파일 (Postman에서 내보낸 *.postman_environment.json)
+type Environment struct {
+	Name   string             `json:"name"`
+	Values []EnvironmentValue `json:"values"`
+}
+
+// 환경 변수 하나 (enabled가 없으면 사용하는 것으로 간주, type이 secret이면 마스킹 대상)
+type EnvironmentValue struct {
+	Key     string      `json:"key"`
+	Value   interface{} `json:"value"`
+	Type    string      `json:"type,omitempty"`
+	Enabled *bool       `json:"enabled,omitempty"`
+}
+
+// 환경 파일 로드
+func loadEnvironment(path string) (*Environment, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("환경 파일 읽기 실패: %v", err)
+	}
+	var environment Environment
+	if err := json.Unmarshal(data, &environment); err != nil {
+		return nil, fmt.Errorf("환경 파일 파싱 실패: %s: %v", path, err)
+	}
+	return &environment, nil
+}
+
+// 컬렉션 변수와 같은 형태로 변환 (nil이면 빈 목록)
+func (e *Environment) variables() []Variable {
+	if e == nil {
+		return nil
+	}
+	variables := make([]Variable, 0, len(e.Values))
+	for _, value := range e.Values {
+		variables = append(variables, Variable{
+			Key:      value.Key,
+			Value:    value.Value,
+			Type:     value.Type,
+			Disabled: value.Enabled != nil && !*value.Enabled,
+		})
+	}
+	return variables
+}
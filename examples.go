@@ -0,0 +1,219 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// 검증 메시지에 포함할 최대 위반 개수 (전체 목록은 TestResult.ExampleViolations에 기록)
+const maxExampleViolationsInMessage = 5
+
+// 예제에 저장돼 있어도 응답마다 달라지거나 전송 경로에 따라 붙는 헤더 (비교하지 않음)
+var volatileExampleHeaders = map[string]bool{
+	"date": true, "age": true, "expires": true, "last-modified": true, "etag": true,
+	"content-length": true, "transfer-encoding": true, "connection": true, "keep-alive": true,
+	"server": true, "via": true, "x-powered-by": true, "set-cookie": true,
+	"x-request-id": true, "x-correlation-id": true, "cf-ray": true, "report-to": true, "nel": true,
+}
+
+// 이름이 이 접두사로 시작하는 헤더도 비교하지 않음 (CORS는 Origin을 보낸 요청에만, 사용량 제한 값은 매번 다름)
+var volatileExampleHeaderPrefixes = []string{"access-control-", "x-ratelimit-", "ratelimit-", "x-amz-", "x-amzn-"}
+
+// 저장된 응답 예제와 실제 응답 비교 (-verify-examples)
+// 상태 코드, 예제에 선언된 헤더, JSON 구조(키와 타입)를 비교하며 values가 true이면 헤더와 JSON 값까지 비교한다
+type exampleVerifier struct {
+	values bool
+}
+
+// 예제가 있는 요청만 비교 결과 추가 (nil이면 아무것도 하지 않음)
+func (v *exampleVerifier) evaluate(item Item, result *TestResult) {
+	if v == nil || len(item.Response) == 0 {
+		return
+	}
+
+	example, violations := matchExample(item.Response, result.StatusCode)
+	if len(violations) == 0 {
+		// 상태 코드가 다른 예제와 본문을 비교하면 의미 없는 차이만 쌓이므로 같은 코드일 때만 비교
+		violations = append(violations, v.compareHeaders(example, result)...)
+		violations = append(violations, v.compareBody(example, result)...)
+	}
+
+	name := fmt.Sprintf("예제 %q 계약", example.Name)
+	if len(violations) == 0 {
+		result.Assertions = append(result.Assertions, AssertionResult{Name: name, Passed: true})
+		return
+	}
+
+	result.ExampleViolations = append(result.ExampleViolations, violations...)
+	messages := violations
+	if len(messages) > maxExampleViolationsInMessage {
+		messages = append(append([]string{}, violations[:maxExampleViolationsInMessage]...),
+			fmt.Sprintf("외 %d개", len(violations)-maxExampleViolationsInMessage))
+	}
+	result.Assertions = append(result.Assertions, AssertionResult{
+		Name:    name,
+		Message: fmt.Sprintf("예제 %q과(와) 다름: %s", example.Name, strings.Join(messages, ", ")),
+	})
+}
+
+// 상태 코드가 같은 예제 선택 (없으면 첫 번째 예제와 상태 코드 불일치를 반환)
+func matchExample(examples []Example, code int) (Example, []string) {
+	var codes []string
+	for _, example := range examples {
+		if example.Code == code {
+			return example, nil
+		}
+		if example.Code != 0 {
+			codes = append(codes, fmt.Sprint(example.Code))
+		}
+	}
+	if len(codes) == 0 {
+		return examples[0], nil // 상태 코드를 기록하지 않은 예제
+	}
+	return examples[0], []string{fmt.Sprintf("상태 코드 %d (예제: %s)", code, strings.Join(codes, ", "))}
+}
+
+// 예제에 선언된 헤더 비교
+// Content-Type은 미디어 타입만 비교하고(charset 등 파라미터는 무시), 나머지 헤더는 있는지 확인한다
+// values가 true이면 나머지 헤더의 값도 비교한다 (Date, ETag 등 응답마다 달라지는 헤더는 제외)
+func (v *exampleVerifier) compareHeaders(example Example, result *TestResult) []string {
+	actualHeaders := http.Header(result.ResponseHeaders)
+	var violations []string
+	for _, header := range example.Header {
+		name := strings.ToLower(strings.TrimSpace(header.Key))
+		if header.Disabled || name == "" || volatileExampleHeader(name) {
+			continue
+		}
+		values, present := actualHeaders[http.CanonicalHeaderKey(name)]
+		actual := strings.Join(values, ", ")
+
+		switch {
+		case name == "content-type":
+			if header.Value != "" && mediaType(header.Value) != mediaType(actual) {
+				violations = append(violations, fmt.Sprintf("Content-Type %q (예제: %q)", actual, header.Value))
+			}
+		case !present:
+			violations = append(violations, fmt.Sprintf("헤더 %s 없음", header.Key))
+		case v.values && strings.TrimSpace(header.Value) != strings.TrimSpace(actual):
+			violations = append(violations, fmt.Sprintf("헤더 %s %q (예제: %q)", header.Key, actual, header.Value))
+		}
+	}
+	return violations
+}
+
+func volatileExampleHeader(name string) bool {
+	if volatileExampleHeaders[name] {
+		return true
+	}
+	for _, prefix := range volatileExampleHeaderPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func mediaType(value string) string {
+	if parsed, _, err := mime.ParseMediaType(value); err == nil {
+		return parsed
+	}
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// 본문 비교 (예제 본문이 비어 있으면 비교하지 않음)
+func (v *exampleVerifier) compareBody(example Example, result *TestResult) []string {
+	if strings.TrimSpace(example.Body) == "" {
+		return nil
+	}
+
+	expected, ok := decodeSnapshotJSON(example.Body)
+	if !ok {
+		// JSON이 아닌 예제는 값 비교 모드에서만 텍스트 그대로 비교
+		if v.values && strings.TrimSpace(example.Body) != strings.TrimSpace(result.ResponseBody) {
+			return []string{"본문이 예제와 다름"}
+		}
+		return nil
+	}
+	actual, ok := decodeSnapshotJSON(result.ResponseBody)
+	if !ok {
+		return []string{"본문이 JSON이 아님"}
+	}
+
+	var violations []string
+	if v.values {
+		diffJSON("$", expected, actual, &violations)
+	} else {
+		diffShape("$", expected, actual, &violations)
+	}
+	return violations
+}
+
+// JSON 구조 비교 (객체의 키와 값의 타입, 배열은 예제의 첫 원소 구조를 모든 원소에 적용)
+func diffShape(path string, expected, actual interface{}, violations *[]string) {
+	wantType, gotType := shapeType(expected), shapeType(actual)
+	if wantType != gotType {
+		*violations = append(*violations, fmt.Sprintf("%s: 타입 %s (예제: %s)", path, gotType, wantType))
+		return
+	}
+
+	switch want := expected.(type) {
+	case map[string]interface{}:
+		got := actual.(map[string]interface{})
+		keys := make([]string, 0, len(want)+len(got))
+		for key := range want {
+			keys = append(keys, key)
+		}
+		for key := range got {
+			if _, exists := want[key]; !exists {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			child := path + jsonPathKey(key)
+			wantValue, inWant := want[key]
+			gotValue, inGot := got[key]
+			switch {
+			case !inGot:
+				*violations = append(*violations, fmt.Sprintf("%s: 없음", child))
+			case !inWant:
+				*violations = append(*violations, fmt.Sprintf("%s: 예제에 없는 필드", child))
+			default:
+				diffShape(child, wantValue, gotValue, violations)
+			}
+		}
+
+	case []interface{}:
+		if len(want) == 0 {
+			return
+		}
+		for i, element := range actual.([]interface{}) {
+			diffShape(fmt.Sprintf("%s[%d]", path, i), want[0], element, violations)
+		}
+	}
+}
+
+// 구조 비교용 JSON 타입 이름 (숫자는 정수/실수를 구분하지 않음)
+func shapeType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffShape(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		actual   string
+		want     []string
+	}{
+		{"same shape different values", `{"id": 1, "name": "kim", "tags": ["a"]}`, `{"id": 2.5, "name": "lee", "tags": []}`, nil},
+		{"nested object", `{"user": {"id": 1, "profile": {"age": 30}}}`, `{"user": {"id": "1", "profile": {"age": 31}}}`,
+			[]string{"$.user.id: 타입 string (예제: number)"}},
+		{"missing and extra fields", `{"a": 1, "b": {"c": true}}`, `{"b": {"d": false}, "e": null}`,
+			[]string{"$.a: 없음", "$.b.c: 없음", "$.b.d: 예제에 없는 필드", "$.e: 예제에 없는 필드"}},
+		{"null is its own type", `{"deleted_at": null}`, `{"deleted_at": "2024-01-01"}`,
+			[]string{"$.deleted_at: 타입 string (예제: null)"}},
+		{"array elements use first example element", `[{"id": 1, "name": "a"}, {"other": true}]`, `[{"id": 1, "name": "a"}, {"id": 2}]`,
+			[]string{"$[1].name: 없음"}},
+		{"nested arrays", `{"rows": [[1, 2]]}`, `{"rows": [[3], ["x"]]}`,
+			[]string{"$.rows[1][0]: 타입 string (예제: number)"}},
+		{"empty example array accepts anything", `{"items": []}`, `{"items": [{"id": 1}, 2]}`, nil},
+		{"array replaced by object", `{"items": []}`, `{"items": {}}`,
+			[]string{"$.items: 타입 object (예제: array)"}},
+		{"root type", `{"id": 1}`, `[]`, []string{"$: 타입 array (예제: object)"}},
+		{"odd keys", `{"a b": 1}`, `{}`, []string{"$['a b']: 없음"}},
+	}
+	for _, tt := range tests {
+		expected, _ := decodeSnapshotJSON(tt.expected)
+		actual, _ := decodeSnapshotJSON(tt.actual)
+		var got []string
+		diffShape("$", expected, actual, &got)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s:\n got  %q\n want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMatchExample(t *testing.T) {
+	examples := []Example{{Name: "ok", Code: 200}, {Name: "missing", Code: 404}}
+	tests := []struct {
+		examples   []Example
+		code       int
+		want       string
+		violations int
+	}{
+		{examples, 404, "missing", 0},
+		{examples, 200, "ok", 0},
+		{examples, 500, "ok", 1},
+		{[]Example{{Name: "no code"}}, 500, "no code", 0},
+	}
+	for _, tt := range tests {
+		example, violations := matchExample(tt.examples, tt.code)
+		if example.Name != tt.want || len(violations) != tt.violations {
+			t.Errorf("matchExample(%d) = %q, %q; want %q with %d violations", tt.code, example.Name, violations, tt.want, tt.violations)
+		}
+	}
+}
+
+func TestExampleCompareHeaders(t *testing.T) {
+	example := Example{Header: ExampleHeaders{
+		{Key: "Content-Type", Value: "application/json; charset=utf-8"},
+		{Key: "Cache-Control", Value: "no-store"},
+		{Key: "X-Api-Version", Value: "2"},
+		{Key: "Date", Value: "Mon, 01 Jan 2024 00:00:00 GMT"},
+		{Key: "Access-Control-Allow-Origin", Value: "*"},
+		{Key: "X-Old", Value: "1", Disabled: true},
+	}}
+	response := map[string][]string{
+		"Content-Type":  {"application/json"},
+		"Cache-Control": {"no-cache"},
+		"X-Api-Version": {"2"},
+	}
+
+	tests := []struct {
+		name     string
+		values   bool
+		response map[string][]string
+		want     []string
+	}{
+		{"presence only", false, response, nil},
+		{"values", true, response, []string{`헤더 Cache-Control "no-cache" (예제: "no-store")`}},
+		{"missing and wrong media type", false, map[string][]string{"Content-Type": {"text/html"}, "X-Api-Version": {"3"}},
+			[]string{`Content-Type "text/html" (예제: "application/json; charset=utf-8")`, "헤더 Cache-Control 없음"}},
+	}
+	for _, tt := range tests {
+		v := &exampleVerifier{values: tt.values}
+		got := v.compareHeaders(example, &TestResult{ResponseHeaders: tt.response})
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s:\n got  %q\n want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestExampleVerifierEvaluate(t *testing.T) {
+	item := Item{Response: []Example{
+		{Name: "created", Code: 201, Header: ExampleHeaders{{Key: "Content-Type", Value: "application/json"}}, Body: `{"id": 1, "email": "a@x"}`},
+		{Name: "invalid", Code: 400, Body: `{"error": "bad"}`},
+	}}
+
+	tests := []struct {
+		name        string
+		values      bool
+		status      int
+		contentType string
+		body        string
+		violations  []string
+	}{
+		{"matching shape", false, 201, "application/json", `{"id": 7, "email": "b@x"}`, nil},
+		{"values compared", true, 201, "application/json", `{"id": 7, "email": "a@x"}`, []string{"$.id: 1 → 7"}},
+		{"other example by status", false, 400, "application/json", `{"error": "nope"}`, nil},
+		{"unknown status skips body and headers", false, 500, "text/html", `<h1>oops</h1>`, []string{"상태 코드 500 (예제: 201, 400)"}},
+		{"not JSON", false, 201, "application/json", `oops`, []string{"본문이 JSON이 아님"}},
+	}
+	for _, tt := range tests {
+		result := TestResult{StatusCode: tt.status, ResponseHeaders: map[string][]string{"Content-Type": {tt.contentType}}, ResponseBody: tt.body}
+		(&exampleVerifier{values: tt.values}).evaluate(item, &result)
+		if !reflect.DeepEqual(result.ExampleViolations, tt.violations) {
+			t.Errorf("%s: violations = %q, want %q", tt.name, result.ExampleViolations, tt.violations)
+		}
+		if len(result.Assertions) != 1 || result.Assertions[0].Passed != (tt.violations == nil) {
+			t.Errorf("%s: assertions = %+v", tt.name, result.Assertions)
+		} else if !strings.Contains(result.Assertions[0].Name, "계약") {
+			t.Errorf("%s: assertion name = %q", tt.name, result.Assertions[0].Name)
+		}
+	}
+
+	var nilVerifier *exampleVerifier
+	result := TestResult{StatusCode: 500}
+	nilVerifier.evaluate(item, &result)
+	if len(result.Assertions) != 0 {
+		t.Errorf("nil verifier added assertions: %+v", result.Assertions)
+	}
+}
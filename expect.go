@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// 설명(description)에 적은 기대 상태 코드 (예: "@expect 404", "@status: 400, 422", "@expect 4xx")
+var expectPattern = regexp.MustCompile(`(?i)(?:^|\s)@(?:expect|status)\b[\s:=]*([0-9x][0-9x ,\-]*)`)
+
+// 요청에 지정된 기대 상태 코드 (지정하지 않았으면 nil, 기본 검증 2xx 사용)
+// 설명의 @expect/@status 주석이 우선이고, 없으면 저장 예제가 모두 2xx가 아닐 때만 예제의 code 목록을 사용한다
+func expectedStatus(item Item) (*statusExpectation, error) {
+	descriptions := []string{descriptionText(item.Description)}
+	if item.Request != nil {
+		descriptions = append(descriptions, descriptionText(item.Request.Description))
+	}
+	for _, description := range descriptions {
+		match := expectPattern.FindStringSubmatch(description)
+		if match == nil {
+			continue
+		}
+		expectation, err := parseStatusExpectation(splitStatusList(match[1]))
+		if err != nil {
+			return nil, fmt.Errorf("@expect 주석 오류: %v", err)
+		}
+		return expectation, nil
+	}
+
+	// 예제는 문서이므로 성공 예제가 하나라도 있으면 기대 상태 코드로 쓰지 않는다
+	var codes []string
+	for _, example := range item.Response {
+		if example.Code == 0 {
+			continue
+		}
+		if example.Code >= 200 && example.Code < 300 {
+			return nil, nil
+		}
+		code := strconv.Itoa(example.Code)
+		if !containsString(codes, code) {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return nil, nil
+	}
+	return parseStatusExpectation(codes)
+}
+
+// "400, 422" 또는 "400 422" 형태의 목록 분리
+func splitStatusList(value string) []string {
+	var values []string
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if part = strings.Trim(part, "-"); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExpectedStatus(t *testing.T) {
+	request := func(description interface{}) *Request {
+		return &Request{Method: "GET", URL: "https://x/", Description: description}
+	}
+
+	tests := []struct {
+		name    string
+		item    Item
+		want    string // 기대 상태 코드 표기 ("" 이면 기본 2xx 검증)
+		wantErr string
+	}{
+		{"no annotation", Item{Name: "List users @expect 404", Request: request("")}, "", ""},
+		{"item description", Item{Description: "invalid email @expect 400", Request: request(nil)}, "400", ""},
+		{"request description object", Item{Request: request(map[string]interface{}{"content": "@status: 400, 422", "type": "text/markdown"})}, "400, 422", ""},
+		{"item description wins", Item{Description: "@expect 404", Request: request("@expect 500")}, "404", ""},
+		{"class and range", Item{Description: "@expect 4xx 500-504", Request: request(nil)}, "4xx, 500-504", ""},
+		{"case and separators", Item{Description: "deleted\n@EXPECT=410 @smoke", Request: request(nil)}, "410", ""},
+		{"among tags", Item{Description: "@smoke @destructive @expect 204", Request: request(nil)}, "204", ""},
+		{"tags are not statuses", Item{Description: "@expected-failure @statusboard", Request: request(nil)}, "", ""},
+		{"e-mail is not an annotation", Item{Description: "contact admin@expect.io", Request: request(nil)}, "", ""},
+		{"bad range", Item{Description: "@expect 500-400", Request: request(nil)}, "", "@expect 주석 오류"},
+		{"annotation beats examples", Item{Description: "@expect 409", Request: request(nil), Response: []Example{{Code: 404}}}, "409", ""},
+		{"only error examples", Item{Request: request(nil), Response: []Example{{Code: 404}, {Code: 410}, {Code: 404}, {Name: "no code"}}}, "404, 410", ""},
+		{"any success example keeps 2xx", Item{Request: request(nil), Response: []Example{{Code: 404}, {Code: 200}}}, "", ""},
+		{"examples without codes", Item{Request: request(nil), Response: []Example{{Name: "doc"}}}, "", ""},
+	}
+	for _, tt := range tests {
+		expectation, err := expectedStatus(tt.item)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s: error = %v, want containing %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		got := ""
+		if expectation != nil {
+			got = expectation.label
+		}
+		if got != tt.want {
+			t.Errorf("%s: expectedStatus = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestStatusExpectationMatches(t *testing.T) {
+	expectation, err := parseStatusExpectation(splitStatusList("404, 4xx 500-502"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var matched []int
+	for _, code := range []int{200, 399, 400, 404, 499, 500, 502, 503} {
+		if expectation.matches(code) {
+			matched = append(matched, code)
+		}
+	}
+	if want := []int{400, 404, 499, 500, 502}; !reflect.DeepEqual(matched, want) {
+		t.Errorf("matched %v, want %v", matched, want)
+	}
+
+	for _, values := range [][]string{{"abc"}, {"6xx"}, {"300-"}, {"2xx0"}} {
+		if _, err := parseStatusExpectation(values); err == nil {
+			t.Errorf("parseStatusExpectation(%q): expected error", values)
+		}
+	}
+}
+
+func TestSplitStatusList(t *testing.T) {
+	tests := map[string][]string{
+		"400, 422":  {"400", "422"},
+		"400 422 ":  {"400", "422"},
+		"4xx,-":     {"4xx"},
+		"200-204 -": {"200-204"},
+	}
+	for input, want := range tests {
+		if got := splitStatusList(input); !reflect.DeepEqual(got, want) {
+			t.Errorf("splitStatusList(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// 설명(description)에 포함된 @태그 (이메일 주소의 @는 제외하기 위해 공백/줄 시작 뒤만 인식)
+var tagPattern = regexp.MustCompile(`(?:^|\s)@([\p{L}\p{N}_-]+)`)
+
+// 요청 이름/태그 기반 필터
+type requestFilter struct {
+	grep        *regexp.Regexp
+	tags        []string
+	excludeTags []string
+}
+
+// 명령줄 값으로부터 필터 생성 (조건이 하나도 없으면 nil 반환)
+func newRequestFilter(grep, tags, excludeTags string) (*requestFilter, error) {
+	f := &requestFilter{
+		tags:        splitList(tags),
+		excludeTags: splitList(excludeTags),
+	}
+	if grep != "" {
+		re, err := regexp.Compile(grep)
+		if err != nil {
+			return nil, fmt.Errorf("잘못된 -grep 정규식: %v", err)
+		}
+		f.grep = re
+	}
+
+	if f.grep == nil && len(f.tags) == 0 && len(f.excludeTags) == 0 {
+		return nil, nil
+	}
+	return f, nil
+}
+
+// 요청을 건너뛸지 판단하고, 건너뛴다면 그 이유를 반환
+func (f *requestFilter) skipReason(name string, tags []string) (string, bool) {
+	if f == nil {
+		return "", false
+	}
+
+	for _, exclude := range f.excludeTags {
+		if containsTag(tags, exclude) {
+			return fmt.Sprintf("제외 태그 @%s", exclude), true
+		}
+	}
+
+	if len(f.tags) > 0 {
+		matched := false
+		for _, tag := range f.tags {
+			if containsTag(tags, tag) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Sprintf("태그 불일치 (필요: @%s)", strings.Join(f.tags, ", @")), true
+		}
+	}
+
+	if f.grep != nil && !f.grep.MatchString(name) {
+		return fmt.Sprintf("이름 불일치 (-grep %s)", f.grep.String()), true
+	}
+
+	return "", false
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// 설명 텍스트에서 @태그 목록 추출
+func parseTags(description string) []string {
+	var tags []string
+	for _, match := range tagPattern.FindAllStringSubmatch(description, -1) {
+		if strings.EqualFold(match[1], "expect") || strings.EqualFold(match[1], "status") {
+			continue // 기대 상태 코드 주석 (expect.go)
+		}
+		tags = append(tags, match[1])
+	}
+	return tags
+}
+
+// description 필드 값 추출 (string 또는 {content, type} 객체 모두 지원)
+func descriptionText(description interface{}) string {
+	switch v := description.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		if content, ok := v["content"].(string); ok {
+			return content
+		}
+	}
+	return ""
+}
+
+// 아이템(및 요청)에 지정된 태그 목록
+func itemTags(item Item) []string {
+	tags := parseTags(descriptionText(item.Description))
+	if item.Request != nil {
+		tags = append(tags, parseTags(descriptionText(item.Request.Description))...)
+	}
+	return tags
+}
+
+// 쉼표로 구분된 목록을 분리 (앞의 @와 공백은 제거)
+func splitList(value string) []string {
+	var list []string
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimPrefix(strings.TrimSpace(part), "@")
+		if part != "" {
+			list = append(list, part)
+		}
+	}
+	return list
+}
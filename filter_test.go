@@ -0,0 +1,91 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTags(t *testing.T) {
+	tests := []struct {
+		description string
+		want        []string
+	}{
+		{"", nil},
+		{"@smoke", []string{"smoke"}},
+		{"사용자 생성 @smoke @critical\n@회원-가입", []string{"smoke", "critical", "회원-가입"}},
+		{"contact admin@example.com or @ops_team.", []string{"ops_team"}},
+		{"@expect 404 @status: 400 @EXPECT=410 @destructive", []string{"destructive"}},
+		{"@expected @statuses", []string{"expected", "statuses"}},
+		{"@ @-", []string{"-"}},
+	}
+	for _, tt := range tests {
+		if got := parseTags(tt.description); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseTags(%q) = %q, want %q", tt.description, got, tt.want)
+		}
+	}
+}
+
+func TestItemTags(t *testing.T) {
+	item := Item{
+		Description: map[string]interface{}{"content": "@smoke", "type": "text/markdown"},
+		Request:     &Request{Description: "@destructive @expect 204"},
+	}
+	if got, want := itemTags(item), []string{"smoke", "destructive"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("itemTags = %q, want %q", got, want)
+	}
+	if got := itemTags(Item{Description: 42}); got != nil {
+		t.Errorf("itemTags(non-text description) = %q, want nil", got)
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	tests := map[string][]string{
+		"":                     nil,
+		" , ,":                 nil,
+		"smoke":                {"smoke"},
+		"@smoke, critical ,@x": {"smoke", "critical", "x"},
+		"a,,b":                 {"a", "b"},
+	}
+	for input, want := range tests {
+		if got := splitList(input); !reflect.DeepEqual(got, want) {
+			t.Errorf("splitList(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestRequestFilterSkipReason(t *testing.T) {
+	if f, err := newRequestFilter("", " , ", ""); f != nil || err != nil {
+		t.Errorf("empty filter = %v, %v; want nil, nil", f, err)
+	}
+	if _, err := newRequestFilter("(", "", ""); err == nil || !strings.Contains(err.Error(), "-grep") {
+		t.Errorf("bad -grep error = %v", err)
+	}
+
+	filter, err := newRequestFilter("^Create", "smoke,@critical", "destructive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		tags   []string
+		skip   bool
+		reason string
+	}{
+		{"Create user", []string{"SMOKE"}, false, ""},
+		{"Create user", []string{"critical", "destructive"}, true, "제외 태그 @destructive"},
+		{"Create user", []string{"slow"}, true, "태그 불일치 (필요: @smoke, @critical)"},
+		{"Delete user", []string{"smoke"}, true, "이름 불일치 (-grep ^Create)"},
+	}
+	for _, tt := range tests {
+		reason, skip := filter.skipReason(tt.name, tt.tags)
+		if skip != tt.skip || reason != tt.reason {
+			t.Errorf("skipReason(%q, %q) = %q, %v; want %q, %v", tt.name, tt.tags, reason, skip, tt.reason, tt.skip)
+		}
+	}
+
+	var none *requestFilter
+	if _, skip := none.skipReason("anything", nil); skip {
+		t.Error("nil filter skipped a request")
+	}
+}
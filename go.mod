@@ -1,3 +1,10 @@
 module postman-tester
 
 go 1.25.1
+
+require (
+	github.com/santhosh-tekuri/jsonschema/v6 v6.0.3
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require golang.org/x/text v0.14.0 // indirect
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// HAR 1.2 형식 (http://www.softwareishard.com/blog/har-12-spec/)
+type harFile struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Pages   []harPage  `json:"pages"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// 컬렉션 하나를 HAR 페이지 하나로 표현
+type harPage struct {
+	StartedDateTime string         `json:"startedDateTime"`
+	ID              string         `json:"id"`
+	Title           string         `json:"title"`
+	PageTimings     harPageTimings `json:"pageTimings"`
+	Latency         *LatencyStats  `json:"_latency,omitempty"` // 응답 시간 통계 (사용자 정의 필드, 단위: 나노초)
+}
+
+type harPageTimings struct {
+	OnContentLoad float64 `json:"onContentLoad"`
+	OnLoad        float64 `json:"onLoad"`
+}
+
+type harEntry struct {
+	PageRef         string            `json:"pageref"`
+	StartedDateTime string            `json:"startedDateTime"`
+	Time            float64           `json:"time"`
+	Request         harRequest        `json:"request"`
+	Response        harResponse       `json:"response"`
+	Cache           struct{}          `json:"cache"`
+	Timings         harTimings        `json:"timings"`
+	Comment         string            `json:"comment,omitempty"`
+	Error           string            `json:"_error,omitempty"`      // 응답을 받지 못한 경우의 오류 (사용자 정의 필드)
+	Assertions      []AssertionResult `json:"_assertions,omitempty"` // 검증 결과 (사용자 정의 필드)
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harCookie    `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harCookie    `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harCookie struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Path     string `json:"path,omitempty"`
+	Domain   string `json:"domain,omitempty"`
+	Expires  string `json:"expires,omitempty"`
+	HTTPOnly bool   `json:"httpOnly,omitempty"`
+	Secure   bool   `json:"secure,omitempty"`
+}
+
+type harPostData struct {
+	MimeType string         `json:"mimeType"`
+	Text     string         `json:"text"`
+	Params   []harNameValue `json:"params,omitempty"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"` // 바이너리 본문은 base64
+}
+
+// 단위: 밀리초, 측정하지 못한 단계는 -1
+type harTimings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+}
+
+func init() {
+	registerReportFormat("har", func() ReportGenerator { return harReport{} })
+}
+
+type harReport struct{}
+
+func (harReport) Extension() string { return "har" }
+
+// 모든 컬렉션의 요청/응답을 하나의 HAR 로그로 생성 (건너뛴 요청은 제외)
+func (harReport) Generate(summaries []*TestSummary) (string, error) {
+	har := harFile{Log: harLog{
+		Version: "1.2",
+		Creator: harCreator{Name: "postman-tester", Version: version},
+		Pages:   make([]harPage, 0, len(summaries)),
+		Entries: make([]harEntry, 0),
+	}}
+
+	for i, summary := range summaries {
+		pageID := fmt.Sprintf("page_%d", i+1)
+		har.Log.Pages = append(har.Log.Pages, harPage{
+			StartedDateTime: harTime(summary.StartTime),
+			ID:              pageID,
+			Title:           summary.CollectionName,
+			PageTimings:     harPageTimings{OnContentLoad: -1, OnLoad: milliseconds(summary.TotalTime)},
+		})
+		if summary.Latency != nil {
+			har.Log.Pages[i].Latency = &summary.Latency.Overall
+		}
+
+		for _, result := range summary.Results {
+			if result.Skipped {
+				continue
+			}
+			har.Log.Entries = append(har.Log.Entries, newHAREntry(pageID, result))
+		}
+	}
+
+	data, err := json.MarshalIndent(har, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func newHAREntry(pageID string, result TestResult) harEntry {
+	entry := harEntry{
+		PageRef:         pageID,
+		StartedDateTime: harTime(result.Timestamp),
+		Time:            milliseconds(result.ResponseTime),
+		Comment:         result.Name,
+		Request:         newHARRequest(result),
+		Response:        newHARResponse(result),
+		Timings:         newHARTimings(result),
+		Assertions:      result.Assertions,
+	}
+	if result.StatusCode == 0 {
+		entry.Error = result.ErrorMessage
+	}
+	return entry
+}
+
+// 단계별 시간을 HAR timings로 변환 (connect는 ssl을 포함, 재사용한 연결의 dns/connect/ssl은 -1)
+func newHARTimings(result TestResult) harTimings {
+	t := result.Timings
+	if t == nil {
+		return harTimings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1, Wait: milliseconds(result.ResponseTime)}
+	}
+
+	timings := harTimings{
+		Blocked: milliseconds(t.Blocked),
+		DNS:     -1,
+		Connect: -1,
+		SSL:     -1,
+		Send:    milliseconds(t.Send),
+		Wait:    milliseconds(t.TTFB),
+		Receive: milliseconds(t.Download),
+	}
+	if !t.ConnectionReused {
+		timings.DNS = milliseconds(t.DNS)
+		timings.Connect = milliseconds(t.Connect + t.TLS)
+		if t.TLS > 0 {
+			timings.SSL = milliseconds(t.TLS)
+		}
+	}
+	return timings
+}
+
+func newHARRequest(result TestResult) harRequest {
+	headers := http.Header{}
+	for key, value := range result.RequestHeaders {
+		headers.Set(key, value)
+	}
+
+	request := harRequest{
+		Method:      result.Method,
+		URL:         result.URL,
+		HTTPVersion: harHTTPVersion(result.HTTPVersion),
+		Cookies:     harRequestCookies(headers),
+		Headers:     harHeaders(headers),
+		QueryString: make([]harNameValue, 0),
+		HeadersSize: -1,
+		BodySize:    len(result.RequestBody),
+	}
+
+	if parsed, err := url.Parse(result.URL); err == nil {
+		request.QueryString = harValues(parsed.Query())
+	}
+
+	if result.RequestBody != "" {
+		mimeType := headers.Get("Content-Type")
+		request.PostData = &harPostData{MimeType: mimeType, Text: result.RequestBody}
+		if strings.HasPrefix(mimeType, "application/x-www-form-urlencoded") {
+			if form, err := url.ParseQuery(result.RequestBody); err == nil {
+				request.PostData.Params = harValues(form)
+			}
+		}
+	}
+
+	return request
+}
+
+func newHARResponse(result TestResult) harResponse {
+	headers := http.Header(result.ResponseHeaders)
+
+	response := harResponse{
+		Status:      result.StatusCode,
+		StatusText:  http.StatusText(result.StatusCode),
+		HTTPVersion: harHTTPVersion(result.HTTPVersion),
+		Cookies:     harResponseCookies(headers),
+		Headers:     harHeaders(headers),
+		RedirectURL: headers.Get("Location"),
+		HeadersSize: -1,
+		BodySize:    result.BodySize,
+		Content: harContent{
+			Size:     result.BodySize,
+			MimeType: headers.Get("Content-Type"),
+			Text:     result.ResponseBody,
+		},
+	}
+
+	// 바이너리 본문은 -binary-bodies base64일 때만 기록되며 이미 base64로 인코딩되어 있음
+	if result.BodyEncoding == binaryBodiesBase64 {
+		response.Content.Encoding = "base64"
+	} else if !utf8.ValidString(result.ResponseBody) {
+		response.Content.Text = base64.StdEncoding.EncodeToString([]byte(result.ResponseBody))
+		response.Content.Encoding = "base64"
+	}
+
+	return response
+}
+
+func harHeaders(headers http.Header) []harNameValue {
+	list := make([]harNameValue, 0, len(headers))
+	for _, key := range sortedHeaderKeys(headers) {
+		for _, value := range headers[key] {
+			list = append(list, harNameValue{Name: key, Value: value})
+		}
+	}
+	return list
+}
+
+func harValues(values url.Values) []harNameValue {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	list := make([]harNameValue, 0, len(values))
+	for _, key := range keys {
+		for _, value := range values[key] {
+			list = append(list, harNameValue{Name: key, Value: value})
+		}
+	}
+	return list
+}
+
+func harRequestCookies(headers http.Header) []harCookie {
+	cookies := make([]harCookie, 0)
+	for _, c := range (&http.Request{Header: headers}).Cookies() {
+		cookies = append(cookies, harCookie{Name: c.Name, Value: c.Value})
+	}
+	return cookies
+}
+
+func harResponseCookies(headers http.Header) []harCookie {
+	cookies := make([]harCookie, 0)
+	for _, c := range (&http.Response{Header: headers}).Cookies() {
+		cookie := harCookie{
+			Name:     c.Name,
+			Value:    c.Value,
+			Path:     c.Path,
+			Domain:   c.Domain,
+			HTTPOnly: c.HttpOnly,
+			Secure:   c.Secure,
+		}
+		if !c.Expires.IsZero() {
+			cookie.Expires = harTime(c.Expires)
+		}
+		cookies = append(cookies, cookie)
+	}
+	return cookies
+}
+
+func sortedHeaderKeys(headers http.Header) []string {
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func harHTTPVersion(proto string) string {
+	if proto == "" {
+		return "HTTP/1.1"
+	}
+	return proto
+}
+
+func harTime(t time.Time) string {
+	return t.Format("2006-01-02T15:04:05.000Z07:00")
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	_ "embed"
+	"html/template"
+	"strings"
+)
+
+// 기본 HTML 리포트 템플릿 (CSS/JS를 모두 포함한 단일 파일, 외부 CDN 사용 안 함)
+//
+//go:embed templates/report.html
+var defaultHTMLTemplate string
+
+func init() {
+	registerReportFormat("html", func() ReportGenerator { return htmlReport{} })
+}
+
+// tmpl이 nil이면 기본 템플릿 사용
+type htmlReport struct {
+	tmpl *template.Template
+}
+
+func (htmlReport) Extension() string { return "html" }
+
+// 사용자 템플릿으로 HTML 리포트 생성 (-template)
+func (htmlReport) WithTemplate(text string) (ReportGenerator, error) {
+	t, err := parseHTMLTemplate(text)
+	if err != nil {
+		return nil, err
+	}
+	return htmlReport{tmpl: t}, nil
+}
+
+func (r htmlReport) Generate(summaries []*TestSummary) (string, error) {
+	t := r.tmpl
+	if t == nil {
+		var err error
+		t, err = parseHTMLTemplate(defaultHTMLTemplate)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var sb strings.Builder
+	err := t.Execute(&sb, newReportData(summaries))
+	if err != nil {
+		return "", err
+	}
+
+	return sb.String(), nil
+}
+
+func parseHTMLTemplate(text string) (*template.Template, error) {
+	return template.New("report").Funcs(template.FuncMap(reportTemplateFuncs)).Parse(text)
+}
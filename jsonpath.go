@@ -0,0 +1,212 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 간단한 JSONPath (루트 $, .key, ['key'], ["key"], [index], 음수 index는 끝에서부터)
+// 와일드카드(.*, [*])와 재귀 탐색(..key)은 여러 값을 가리키는 패턴용이며, 필터 식은 지원하지 않는다
+type jsonPath struct {
+	raw      string
+	segments []jsonPathSegment
+}
+
+type jsonPathSegment struct {
+	key       string
+	index     int
+	isIndex   bool
+	wildcard  bool // 모든 키 또는 모든 원소
+	recursive bool // 현재 위치와 모든 하위 위치에서 찾음 (..)
+}
+
+func parseJSONPath(path string) (*jsonPath, error) {
+	p := &jsonPath{raw: path}
+	rest := strings.TrimSpace(path)
+	if !strings.HasPrefix(rest, "$") {
+		return nil, fmt.Errorf("JSONPath는 $로 시작해야 합니다: %s", path)
+	}
+	rest = rest[1:]
+
+	for rest != "" {
+		recursive := false
+		if strings.HasPrefix(rest, "..") {
+			recursive = true
+			rest = rest[1:]
+			if strings.HasPrefix(rest, ".[") {
+				rest = rest[1:]
+			}
+		}
+
+		switch {
+		case rest[0] == '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			key := rest[:end]
+			if key == "" {
+				return nil, fmt.Errorf("JSONPath 형식 오류: %s", path)
+			}
+			p.segments = append(p.segments, jsonPathSegment{key: key, wildcard: key == "*", recursive: recursive})
+			rest = rest[end:]
+
+		case rest[0] == '[':
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("JSONPath의 [가 닫히지 않았습니다: %s", path)
+			}
+			inner := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+
+			if inner == "*" {
+				p.segments = append(p.segments, jsonPathSegment{wildcard: true, recursive: recursive})
+				continue
+			}
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				p.segments = append(p.segments, jsonPathSegment{key: inner[1 : len(inner)-1], recursive: recursive})
+				continue
+			}
+			index, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("JSONPath의 배열 index가 올바르지 않습니다: %s", path)
+			}
+			p.segments = append(p.segments, jsonPathSegment{index: index, isIndex: true, recursive: recursive})
+
+		default:
+			return nil, fmt.Errorf("JSONPath 형식 오류: %s", path)
+		}
+	}
+
+	return p, nil
+}
+
+// 와일드카드나 재귀 탐색이 있어 여러 값을 가리킬 수 있는지 여부
+func (p *jsonPath) isPattern() bool {
+	for _, segment := range p.segments {
+		if segment.wildcard || segment.recursive {
+			return true
+		}
+	}
+	return false
+}
+
+// 값 조회 (경로에 해당하는 값이 없거나 패턴 경로이면 ok=false)
+func (p *jsonPath) lookup(document interface{}) (interface{}, bool) {
+	current := document
+	for _, segment := range p.segments {
+		if segment.wildcard || segment.recursive {
+			return nil, false
+		}
+		if segment.isIndex {
+			array, ok := current.([]interface{})
+			if !ok {
+				return nil, false
+			}
+			index := segment.index
+			if index < 0 {
+				index += len(array)
+			}
+			if index < 0 || index >= len(array) {
+				return nil, false
+			}
+			current = array[index]
+			continue
+		}
+
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[segment.key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// 경로와 일치하는 모든 값을 replacement로 바꿈 (document를 직접 수정하며, 루트가 일치하면 replacement를 반환)
+func (p *jsonPath) replaceAll(document interface{}, replacement interface{}) interface{} {
+	return replaceMatches(document, p.segments, replacement)
+}
+
+func replaceMatches(node interface{}, segments []jsonPathSegment, replacement interface{}) interface{} {
+	if len(segments) == 0 {
+		return replacement
+	}
+	segment := segments[0]
+
+	if segment.recursive {
+		// 현재 위치에서 일치시킨 뒤 모든 하위 값에서도 같은 패턴을 찾는다
+		here := segment
+		here.recursive = false
+		node = replaceMatches(node, append([]jsonPathSegment{here}, segments[1:]...), replacement)
+		switch v := node.(type) {
+		case map[string]interface{}:
+			for key, child := range v {
+				v[key] = replaceMatches(child, segments, replacement)
+			}
+		case []interface{}:
+			for i, child := range v {
+				v[i] = replaceMatches(child, segments, replacement)
+			}
+		}
+		return node
+	}
+
+	switch v := node.(type) {
+	case map[string]interface{}:
+		if segment.isIndex {
+			return node
+		}
+		for key, child := range v {
+			if segment.wildcard || key == segment.key {
+				v[key] = replaceMatches(child, segments[1:], replacement)
+			}
+		}
+	case []interface{}:
+		if !segment.isIndex && !segment.wildcard {
+			return node
+		}
+		index := segment.index
+		if index < 0 {
+			index += len(v)
+		}
+		for i, child := range v {
+			if segment.wildcard || i == index {
+				v[i] = replaceMatches(child, segments[1:], replacement)
+			}
+		}
+	}
+	return node
+}
+
+func (p *jsonPath) String() string {
+	return p.raw
+}
+
+// JSON 값의 타입 이름 (string, number, integer, boolean, array, object, null)
+func jsonTypeName(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == float64(int64(v)) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
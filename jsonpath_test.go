@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseJSONPathErrors(t *testing.T) {
+	// 필터 식과 슬라이스는 지원하지 않으므로 로드 시점에 오류로 알린다
+	for _, path := range []string{
+		"",
+		"data.id",
+		"$.",
+		"$[0",
+		"$.items[?(@.id == 1)]",
+		"$.items[0:2]",
+		"$.items[-1:]",
+		"$.items[a]",
+		"$id",
+	} {
+		if _, err := parseJSONPath(path); err == nil {
+			t.Errorf("parseJSONPath(%q): expected error", path)
+		}
+	}
+}
+
+func TestJSONPathLookup(t *testing.T) {
+	document, _ := decodeSnapshotJSON(`{
+		"data": {"id": 7, "items": [{"name": "a"}, {"name": "b"}, {"name": "c"}]},
+		"odd key": true,
+		"empty": null
+	}`)
+
+	tests := []struct {
+		path  string
+		want  interface{}
+		found bool
+	}{
+		{"$", document, true},
+		{"$.data.id", "7", true},
+		{"$.data.items[0].name", "a", true},
+		{"$.data.items[-1].name", "c", true},
+		{"$['odd key']", true, true},
+		{`$["data"]["id"]`, "7", true},
+		{"$.empty", nil, true},
+		{"$.data.items[3]", nil, false},
+		{"$.data.items[-4]", nil, false},
+		{"$.data.id.value", nil, false},
+		{"$.missing", nil, false},
+		{"$.data.items[*].name", nil, false}, // 패턴 경로는 단일 값 조회 불가
+		{"$..id", nil, false},
+	}
+	for _, tt := range tests {
+		path, err := parseJSONPath(tt.path)
+		if err != nil {
+			t.Fatalf("parseJSONPath(%q): %v", tt.path, err)
+		}
+		got, found := path.lookup(document)
+		if n, ok := got.(interface{ String() string }); ok {
+			got = n.String() // json.Number는 문자열로 비교
+		}
+		if found != tt.found || (found && !reflect.DeepEqual(got, tt.want)) {
+			t.Errorf("lookup(%q) = %v, %v; want %v, %v", tt.path, got, found, tt.want, tt.found)
+		}
+	}
+}
+
+func TestJSONPathIsPattern(t *testing.T) {
+	tests := map[string]bool{
+		"$.a.b":      false,
+		"$.a[0]":     false,
+		"$.a[*]":     true,
+		"$.a.*":      true,
+		"$..id":      true,
+		"$..[0]":     true,
+		"$['a'].b":   false,
+		"$.a[*].b.c": true,
+	}
+	for raw, want := range tests {
+		path, err := parseJSONPath(raw)
+		if err != nil {
+			t.Fatalf("parseJSONPath(%q): %v", raw, err)
+		}
+		if got := path.isPattern(); got != want {
+			t.Errorf("isPattern(%q) = %v, want %v", raw, got, want)
+		}
+	}
+}
+
+func TestJSONPathReplaceAll(t *testing.T) {
+	const body = `{"id": 1, "user": {"id": 2, "token": "t1", "sessions": [{"id": 3, "token": "t2"}, {"id": 4}]}, "tags": ["x", "y"]}`
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"$.id", `{"id":"#","tags":["x","y"],"user":{"id":2,"sessions":[{"id":3,"token":"t2"},{"id":4}],"token":"t1"}}`},
+		{"$..id", `{"id":"#","tags":["x","y"],"user":{"id":"#","sessions":[{"id":"#","token":"t2"},{"id":"#"}],"token":"t1"}}`},
+		{"$..token", `{"id":1,"tags":["x","y"],"user":{"id":2,"sessions":[{"id":3,"token":"#"},{"id":4}],"token":"#"}}`},
+		{"$.user.sessions[*].id", `{"id":1,"tags":["x","y"],"user":{"id":2,"sessions":[{"id":"#","token":"t2"},{"id":"#"}],"token":"t1"}}`},
+		{"$.user.sessions[-1]", `{"id":1,"tags":["x","y"],"user":{"id":2,"sessions":[{"id":3,"token":"t2"},"#"],"token":"t1"}}`},
+		{"$.tags.*", `{"id":1,"tags":["#","#"],"user":{"id":2,"sessions":[{"id":3,"token":"t2"},{"id":4}],"token":"t1"}}`},
+		{"$.missing.id", `{"id":1,"tags":["x","y"],"user":{"id":2,"sessions":[{"id":3,"token":"t2"},{"id":4}],"token":"t1"}}`},
+		{"$", `"#"`},
+	}
+	for _, tt := range tests {
+		document, _ := decodeSnapshotJSON(body)
+		path, err := parseJSONPath(tt.path)
+		if err != nil {
+			t.Fatalf("parseJSONPath(%q): %v", tt.path, err)
+		}
+		got, err := json.Marshal(path.replaceAll(document, "#"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("replaceAll(%q)\n got  %s\n want %s", tt.path, got, tt.want)
+		}
+	}
+}
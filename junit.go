@@ -0,0 +1,185 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// JUnit XML 형식 (Jenkins, GitLab CI 테스트 리포트 호환)
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+// 컬렉션 하나가 testsuite 하나에 대응
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Timestamp  string          `xml:"timestamp,attr"`
+	Hostname   string          `xml:"hostname,attr,omitempty"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+// 요청 하나가 testcase 하나에 대응 (폴더 경로는 classname 접두사로 표현)
+type junitTestCase struct {
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Failure    *junitMessage   `xml:"failure,omitempty"`
+	Error      *junitMessage   `xml:"error,omitempty"`
+	Skipped    *junitMessage   `xml:"skipped,omitempty"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+func init() {
+	registerReportFormat("junit", func() ReportGenerator { return junitReport{} })
+}
+
+type junitReport struct{}
+
+func (junitReport) Extension() string { return "xml" }
+
+func (junitReport) Generate(summaries []*TestSummary) (string, error) {
+	hostname, _ := os.Hostname()
+	suites := junitTestSuites{Name: "postman-tester"}
+	var totalSeconds float64
+
+	for _, summary := range summaries {
+		suite := junitTestSuite{
+			Name:      summary.CollectionName,
+			Time:      junitSeconds(summary.TotalTime.Seconds()),
+			Timestamp: summary.StartTime.Format("2006-01-02T15:04:05"),
+			Hostname:  hostname,
+			Properties: []junitProperty{
+				{Name: "file", Value: summary.FilePath},
+			},
+		}
+		if latency := summary.Latency; latency != nil {
+			suite.Properties = append(suite.Properties, junitLatencyProperties(latency.Overall)...)
+		}
+
+		for _, result := range summary.Results {
+			testCase := newJUnitTestCase(summary.CollectionName, result)
+			switch {
+			case testCase.Skipped != nil:
+				suite.Skipped++
+			case testCase.Error != nil:
+				suite.Errors++
+			case testCase.Failure != nil:
+				suite.Failures++
+			}
+			suite.Tests++
+			suite.TestCases = append(suite.TestCases, testCase)
+		}
+
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+		totalSeconds += summary.TotalTime.Seconds()
+		suites.Suites = append(suites.Suites, suite)
+	}
+	suites.Time = junitSeconds(totalSeconds)
+
+	data, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(data) + "\n", nil
+}
+
+func newJUnitTestCase(collection string, result TestResult) junitTestCase {
+	className := collection
+	if result.Folder != "" {
+		className += "." + strings.ReplaceAll(result.Folder, "/", ".")
+	}
+
+	testCase := junitTestCase{
+		Name:      result.Name,
+		ClassName: className,
+		Time:      junitSeconds(result.ResponseTime.Seconds()),
+		Properties: []junitProperty{
+			{Name: "method", Value: result.Method},
+			{Name: "url", Value: result.URL},
+			{Name: "status_code", Value: fmt.Sprintf("%d", result.StatusCode)},
+		},
+	}
+
+	switch {
+	case result.Skipped:
+		testCase.Skipped = &junitMessage{Message: result.SkipReason}
+	case result.Success:
+	case result.StatusCode == 0:
+		// 응답을 받지 못한 경우 (연결 실패, 요청 생성 실패 등)
+		testCase.Error = &junitMessage{
+			Message: result.ErrorMessage,
+			Type:    "RequestError",
+			Text:    fmt.Sprintf("%s %s\n%s", result.Method, result.URL, result.ErrorMessage),
+		}
+	default:
+		text := fmt.Sprintf("%s %s\nHTTP %d\n", result.Method, result.URL, result.StatusCode)
+		for _, assertion := range result.Assertions {
+			if assertion.Passed {
+				text += fmt.Sprintf("✔ %s\n", assertion.Name)
+			} else {
+				text += fmt.Sprintf("✘ %s: %s\n", assertion.Name, assertion.Message)
+			}
+		}
+		testCase.Failure = &junitMessage{
+			Message: result.ErrorMessage,
+			Type:    "AssertionFailure",
+			Text:    text,
+		}
+	}
+
+	return testCase
+}
+
+// 응답 시간 통계를 testsuite 속성으로 표현 (단위: 밀리초)
+func junitLatencyProperties(stats LatencyStats) []junitProperty {
+	values := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"min", stats.Min}, {"max", stats.Max}, {"mean", stats.Mean}, {"stddev", stats.StdDev},
+		{"p50", stats.P50}, {"p90", stats.P90}, {"p95", stats.P95}, {"p99", stats.P99},
+	}
+
+	properties := make([]junitProperty, 0, len(values))
+	for _, v := range values {
+		properties = append(properties, junitProperty{
+			Name:  "latency." + v.name + "_ms",
+			Value: fmt.Sprintf("%.3f", milliseconds(v.value)),
+		})
+	}
+	return properties
+}
+
+func junitSeconds(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
+}
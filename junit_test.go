@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/xml"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 리포터 출력 비교용 실행 결과 (시각과 소요 시간을 고정)
+func reportTestSummaries() []*TestSummary {
+	start := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	results := []TestResult{
+		{Name: "List users", Folder: "Users/Admin", Method: "GET", URL: "https://api.example.com/users", StatusCode: 200,
+			Success: true, ResponseTime: 120 * time.Millisecond, Timestamp: start,
+			Assertions: []AssertionResult{{Name: "상태 코드 2xx", Passed: true}}},
+		{Name: `Create <user> & "admin"`, Folder: "Users", Method: "POST", URL: "https://api.example.com/users?role=a&b", StatusCode: 422,
+			ResponseTime: 80 * time.Millisecond, Timestamp: start.Add(200 * time.Millisecond), ErrorMessage: "검증 실패",
+			Timings: &RequestTimings{DNS: 2 * time.Millisecond, Connect: 5 * time.Millisecond, TTFB: 70 * time.Millisecond, Download: 3 * time.Millisecond},
+			Assertions: []AssertionResult{
+				{Name: "상태 코드 2xx", Message: "예상 2xx, 실제 422"},
+				{Name: "응답 시간", Passed: true},
+			}},
+		{Name: "Health", Method: "GET", URL: "https://down.example.com/health", Timestamp: start.Add(400 * time.Millisecond),
+			ErrorMessage: "요청 실패: connection refused"},
+		{Name: "Delete user", Folder: "Users", Method: "DELETE", Skipped: true, SkipReason: "제외 태그 @destructive",
+			Timestamp: start.Add(500 * time.Millisecond)},
+	}
+	return []*TestSummary{{
+		CollectionName: "Users API",
+		FilePath:       "collections/users.json",
+		TotalTests:     4,
+		PassedTests:    1,
+		FailedTests:    2,
+		SkippedTests:   1,
+		TotalTime:      1500 * time.Millisecond,
+		Results:        results,
+		Latency:        newCollectionLatency(results),
+		StartTime:      start,
+		EndTime:        start.Add(1500 * time.Millisecond),
+	}}
+}
+
+// CI 도구가 읽는 형식이므로 출력 전체를 고정해 비교한다
+func TestJUnitReportGolden(t *testing.T) {
+	got, err := junitReport{}.Generate(reportTestSummaries())
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostname, _ := os.Hostname()
+	got = strings.Replace(got, ` hostname="`+hostname+`"`, ` hostname="HOST"`, 1)
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="postman-tester" tests="4" failures="1" errors="1" skipped="1" time="1.500">
+  <testsuite name="Users API" tests="4" failures="1" errors="1" skipped="1" time="1.500" timestamp="2024-03-01T09:30:00" hostname="HOST">
+    <properties>
+      <property name="file" value="collections/users.json"></property>
+      <property name="latency.min_ms" value="80.000"></property>
+      <property name="latency.max_ms" value="120.000"></property>
+      <property name="latency.mean_ms" value="100.000"></property>
+      <property name="latency.stddev_ms" value="20.000"></property>
+      <property name="latency.p50_ms" value="80.000"></property>
+      <property name="latency.p90_ms" value="120.000"></property>
+      <property name="latency.p95_ms" value="120.000"></property>
+      <property name="latency.p99_ms" value="120.000"></property>
+    </properties>
+    <testcase name="List users" classname="Users API.Users.Admin" time="0.120">
+      <properties>
+        <property name="method" value="GET"></property>
+        <property name="url" value="https://api.example.com/users"></property>
+        <property name="status_code" value="200"></property>
+      </properties>
+    </testcase>
+    <testcase name="Create &lt;user&gt; &amp; &#34;admin&#34;" classname="Users API.Users" time="0.080">
+      <properties>
+        <property name="method" value="POST"></property>
+        <property name="url" value="https://api.example.com/users?role=a&amp;b"></property>
+        <property name="status_code" value="422"></property>
+      </properties>
+      <failure message="검증 실패" type="AssertionFailure">POST https://api.example.com/users?role=a&amp;b&#xA;HTTP 422&#xA;✘ 상태 코드 2xx: 예상 2xx, 실제 422&#xA;✔ 응답 시간&#xA;</failure>
+    </testcase>
+    <testcase name="Health" classname="Users API" time="0.000">
+      <properties>
+        <property name="method" value="GET"></property>
+        <property name="url" value="https://down.example.com/health"></property>
+        <property name="status_code" value="0"></property>
+      </properties>
+      <error message="요청 실패: connection refused" type="RequestError">GET https://down.example.com/health&#xA;요청 실패: connection refused</error>
+    </testcase>
+    <testcase name="Delete user" classname="Users API.Users" time="0.000">
+      <properties>
+        <property name="method" value="DELETE"></property>
+        <property name="url" value=""></property>
+        <property name="status_code" value="0"></property>
+      </properties>
+      <skipped message="제외 태그 @destructive"></skipped>
+    </testcase>
+  </testsuite>
+</testsuites>
+`
+	if got != want {
+		t.Errorf("JUnit XML:\n got:\n%s\n want:\n%s", got, want)
+	}
+
+	var decoded junitTestSuites
+	if err := xml.Unmarshal([]byte(got), &decoded); err != nil {
+		t.Fatalf("output is not valid XML: %v", err)
+	}
+	if name := decoded.Suites[0].TestCases[1].Name; name != `Create <user> & "admin"` {
+		t.Errorf("decoded testcase name = %q", name)
+	}
+}
+
+func TestJUnitReportEmpty(t *testing.T) {
+	got, err := junitReport{}.Generate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := xml.Header + `<testsuites name="postman-tester" tests="0" failures="0" errors="0" skipped="0" time="0.000"></testsuites>` + "\n"
+	if got != want {
+		t.Errorf("empty JUnit XML = %q, want %q", got, want)
+	}
+}
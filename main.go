@@ -1,8 +1,10 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
@@ -10,18 +12,54 @@ import (
 	"sync"
 )
 
+// 빌드 시 -ldflags "-X main.version=1.2.3"으로 지정
+var version = "dev"
+
+// 진행 상황/요약 메시지 출력 대상 (표준 출력으로 이벤트를 스트리밍할 때는 표준 에러로 전환)
+var console = os.Stdout
+
 var (
-	directory = flag.String("dir", "./postman", "Postman 컬렉션 파일들이 있는 디렉토리")
-	file      = flag.String("file", "", "단일 Postman 컬렉션 파일 (이 옵션 사용시 -dir 무시)")
-	output    = flag.String("output", "", "결과를 저장할 파일 (선택사항, 기본값: 콘솔 출력)")
-	format    = flag.String("format", "text", "출력 형식 (text, json, html, csv)")
-	parallel  = flag.Int("parallel", 1, "병렬 실행할 컬렉션 수 (기본값: 1)")
-	timeout   = flag.Int("timeout", 30, "요청 타임아웃 (초, 기본값: 30)")
-	verbose   = flag.Bool("verbose", false, "상세 출력")
-	help      = flag.Bool("help", false, "도움말 표시")
+	directory  = flag.String("dir", "./postman", "Postman 컬렉션 파일들이 있는 디렉토리")
+	file       = flag.String("file", "", "단일 Postman 컬렉션 파일 (이 옵션 사용시 -dir 무시)")
+	output     = flag.String("output", "", "결과를 저장할 파일 (선택사항, 기본값: 콘솔 출력)")
+	format     = flag.String("format", "text", "출력 형식 (text, json, html, csv, markdown, har, junit, tap, ndjson, coverage)")
+	parallel   = flag.Int("parallel", 1, "병렬 실행할 컬렉션 수 (기본값: 1)")
+	timeout    = flag.Int("timeout", 30, "요청 타임아웃 (초, 기본값: 30)")
+	verbose    = flag.Bool("verbose", false, "상세 출력")
+	grep       = flag.String("grep", "", "이름이 정규식과 일치하는 요청만 실행 (예: \"^Create\")")
+	tag        = flag.String("tag", "", "설명에 지정된 태그 중 하나라도 가진 요청만 실행 (쉼표 구분, 예: smoke,critical)")
+	excludeTag = flag.String("exclude-tag", "", "지정된 태그를 가진 요청은 건너뜀 (쉼표 구분, 예: destructive)")
+	dryRun     = flag.Bool("dry-run", false, "요청을 전송하지 않고 변수/인증/본문이 해석된 최종 요청만 출력")
+	curl       = flag.Bool("curl", false, "실행한 각 요청을 재현할 수 있는 curl 명령으로 리포트에 포함")
+	curlShell  = flag.String("curl-shell", defaultCurlShell(), "curl 명령 인용 방식 (bash, powershell)")
+	reveal     = flag.Bool("reveal-secrets", false, "리포트와 curl 명령에서 인증 헤더와 secret 변수 값을 마스킹하지 않음")
+	reporters  = flag.String("reporter", "", "함께 사용할 리포터 목록 (쉼표 구분, 예: cli,junit,html), 지정 시 -format/-output 대신 사용")
+	htmlTmpl   = flag.String("template", "", "HTML 리포트에 사용할 사용자 템플릿 파일 (html/template 문법)")
+	textTmpl   = flag.String("text-template", "", "텍스트 리포트에 사용할 사용자 템플릿 파일 (text/template 문법)")
+	csvColumns = flag.String("csv-columns", "", "CSV에 출력할 열과 순서 (쉼표 구분, 예: Collection,Folder,TestName,Success)")
+	csvDelim   = flag.String("csv-delimiter", ",", "CSV 구분자 (한 글자, 탭은 tab)")
+	csvRows    = flag.String("csv-rows", csvRowsRequest, "CSV 행 단위 (request: 요청마다 한 행, assertion: 검증 결과마다 한 행, latency: 응답 시간 통계)")
+	csvBOM     = flag.Bool("csv-bom", true, "CSV 앞에 UTF-8 BOM 추가 (Excel 한글 표시용)")
+	checksFile = flag.String("assertions", "", "요청 이름/폴더별 선언적 검증 파일 (YAML 또는 JSON)")
+	schemaDir  = flag.String("schemas", "", "요청 이름별 JSON Schema 파일 디렉토리 (<요청 이름>.schema.json)")
+	snapDir    = flag.String("snapshot-dir", "", "응답 본문 스냅샷 디렉토리 (처음 실행 시 저장, 이후 비교)")
+	snapUpdate = flag.Bool("update-snapshots", false, "스냅샷을 현재 응답으로 다시 저장")
+	snapIgnore = flag.String("snapshot-ignore", "", "스냅샷 비교에서 제외할 JSONPath 목록 (쉼표 구분, 예: $..id,$..createdAt)")
+	verifyEx   = flag.Bool("verify-examples", false, "응답을 요청에 저장된 예제와 비교 (상태 코드, 예제에 선언된 헤더, JSON 구조)")
+	exValues   = flag.Bool("example-values", false, "-verify-examples에서 헤더와 JSON 값까지 비교")
+	openAPIDoc = flag.String("openapi", "", "응답을 검증할 OpenAPI 3.x 명세 파일 (YAML 또는 JSON)")
+	maxTime    = flag.String("max-response-time", "", "모든 요청의 응답 시간 SLA (예: 500ms, 2s), 넘으면 SLA 초과로 실패")
+	slaPath    = flag.String("sla", "", "폴더/요청별 응답 시간 제한과 p95 등 성능 예산 파일 (YAML 또는 JSON)")
+	maxCapture = flag.String("max-body-capture", "", "리포트에 기록할 응답 본문 최대 크기 (예: 64KB, 1MB), 넘으면 잘라서 기록")
+	binBodies  = flag.String("binary-bodies", binaryBodiesHash, "바이너리 응답 본문 기록 방식 (hash: 크기와 SHA-256, base64: base64 인코딩)")
+	noBodies   = flag.Bool("no-bodies", false, "리포트에 응답 본문을 기록하지 않음 (크기만 기록, 검증에는 전체 본문 사용)")
+	envFile    = flag.String("environment", "", "Postman 환경 파일 (컬렉션 변수보다 우선, secret 타입 값은 리포트에서 마스킹)")
+	redactList = flag.String("redact", "", "리포트에서 가릴 항목 (쉼표 구분, /정규식/, JSONPath, 헤더/파라미터 이름, 예: $..token,/sk_[a-z0-9]+/)")
+	help       = flag.Bool("help", false, "도움말 표시")
 )
 
 func main() {
+	exportPaths := registerReporterExportFlags()
 	flag.Parse()
 
 	if *help {
@@ -55,14 +93,126 @@ func main() {
 		}
 	}
 
-	fmt.Printf("🚀 %d개의 Postman 컬렉션을 테스트합니다...\n\n", len(files))
+	filter, err := newRequestFilter(*grep, *tag, *excludeTag)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var assertions *assertionSet
+	if *checksFile != "" {
+		assertions, err = loadAssertions(*checksFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	var schemas *schemaDirectory
+	if *schemaDir != "" {
+		schemas, err = newSchemaDirectory(*schemaDir, newSchemaCompiler())
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	var snapshots *snapshotStore
+	if *snapDir != "" {
+		root := *directory
+		if *file != "" {
+			root = ""
+		}
+		snapshots, err = newSnapshotStore(*snapDir, root, *snapUpdate, *snapIgnore)
+		if err != nil {
+			log.Fatal(err)
+		}
+	} else if *snapUpdate {
+		log.Fatal("-update-snapshots는 -snapshot-dir과 함께 사용해야 합니다")
+	}
+	var examples *exampleVerifier
+	if *verifyEx {
+		examples = &exampleVerifier{values: *exValues}
+	} else if *exValues {
+		log.Fatal("-example-values는 -verify-examples와 함께 사용해야 합니다")
+	}
+	var spec *openAPISpec
+	if *openAPIDoc != "" {
+		spec, err = loadOpenAPISpec(*openAPIDoc)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	sla, err := newSLAPolicy(*maxTime, *slaPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	capture, err := newBodyCapture(*maxCapture, *binBodies, *noBodies)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var environment *Environment
+	if *envFile != "" {
+		environment, err = loadEnvironment(*envFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	redact, err := newRedactor(*redactList, *reveal)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *curlShell != shellBash && *curlShell != shellPowerShell {
+		log.Fatalf("지원하지 않는 -curl-shell 값: %s (bash, powershell 중 선택)", *curlShell)
+	}
+	csvConfig, err := newCSVOptions(*csvColumns, *csvDelim, *csvRows, *csvBOM)
+	if err != nil {
+		log.Fatal(err)
+	}
+	targets, err := buildReportTargets(exportPaths)
+	if err != nil {
+		log.Fatal(err)
+	}
+	configureCSVReports(targets, csvConfig)
+	if err := applyReportTemplates(targets); err != nil {
+		log.Fatal(err)
+	}
+	listener, closeLiveReports, err := startLiveReports(targets)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// 실행 옵션이 적용된 러너 생성 함수 (병렬 실행 시 워커마다 하나씩 사용)
+	newRunner := func() *Runner {
+		runner := NewRunner()
+		runner.filter = filter
+		runner.dryRun = *dryRun
+		if *curl {
+			runner.curlShell = *curlShell
+		}
+		runner.assertions = assertions
+		runner.schemas = schemas
+		runner.snapshots = snapshots
+		runner.examples = examples
+		runner.openAPI = spec
+		runner.sla = sla
+		runner.capture = capture
+		runner.redact = redact
+		runner.envVars = environment.variables()
+		runner.listener = listener
+		return runner
+	}
+
+	if *dryRun {
+		fmt.Fprintf(console, "🔍 드라이런: %d개의 Postman 컬렉션의 요청을 해석합니다 (전송하지 않음)...\n\n", len(files))
+	} else {
+		fmt.Fprintf(console, "🚀 %d개의 Postman 컬렉션을 테스트합니다...\n\n", len(files))
+	}
+
+	if listener != nil {
+		listener.RunStarted(files, *dryRun)
+	}
 
 	// 모든 컬렉션 실행 (병렬 처리 지원)
 	allResults := make([]*TestSummary, 0, len(files))
-	
+
 	if *parallel <= 1 {
 		// 순차 실행
-		runner := NewRunner()
+		runner := newRunner()
 		for i, file := range files {
 			result := runSingleCollection(runner, file, i+1, len(files), *verbose)
 			if result != nil {
@@ -71,40 +221,170 @@ func main() {
 		}
 	} else {
 		// 병렬 실행
-		allResults = runCollectionsInParallel(files, *parallel, *verbose)
+		allResults = runCollectionsInParallel(files, *parallel, *verbose, console, newRunner)
+	}
+
+	if listener != nil {
+		listener.RunFinished(allResults)
+	}
+	if err := closeLiveReports(); err != nil {
+		log.Fatalf("결과 저장 실패: %v", err)
 	}
 
-	// 최종 결과 출력
-	reporter := NewReporter(*format)
-	if *output != "" {
-		err := reporter.SaveToFile(allResults, *output)
+	// 최종 결과 출력 (리포터별로 콘솔 또는 파일, 실시간 리포트는 이미 기록됨)
+	for _, target := range targets {
+		if target.live {
+			if target.path != "" {
+				fmt.Fprintf(console, "📊 결과가 저장되었습니다: %s\n", target.path)
+			}
+			continue
+		}
+		if target.path == "" {
+			target.reporter.Print(allResults)
+			continue
+		}
+		err := target.reporter.SaveToFile(allResults, target.path)
 		if err != nil {
 			log.Fatalf("결과 저장 실패: %v", err)
 		}
-		fmt.Printf("📊 결과가 저장되었습니다: %s\n", *output)
-	} else {
-		reporter.Print(allResults)
+		fmt.Fprintf(console, "📊 결과가 저장되었습니다: %s\n", target.path)
 	}
 
 	// 전체 요약
 	printOverallSummary(allResults)
 }
 
+// 결과를 출력할 리포터와 저장 경로 (경로가 비어있으면 콘솔 출력)
+type reportTarget struct {
+	reporter *Reporter
+	path     string
+	live     bool // 실행 중에 바로 기록하는 리포트 (ndjson 등)
+}
+
+// 콘솔에 텍스트 리포트를 출력하는 리포터 이름 (-reporter 전용)
+const consoleReporter = "cli"
+
+// 등록된 리포트 형식마다 -reporter-<이름>-export 옵션 생성
+func registerReporterExportFlags() map[string]*string {
+	paths := make(map[string]*string)
+	for _, name := range reportFormatNames() {
+		usage := fmt.Sprintf("-reporter에 %s 포함 시 저장 경로 (기본값: %s)", name, NewReporter(name).DefaultFilename())
+		paths[name] = flag.String("reporter-"+name+"-export", "", usage)
+	}
+	return paths
+}
+
+// -reporter 목록(또는 -format/-output)으로부터 출력 대상 구성
+func buildReportTargets(exportPaths map[string]*string) ([]reportTarget, error) {
+	if *reporters == "" {
+		return []reportTarget{{reporter: NewReporter(*format), path: *output}}, nil
+	}
+
+	var targets []reportTarget
+	for _, name := range splitList(*reporters) {
+		if name == consoleReporter {
+			targets = append(targets, reportTarget{reporter: NewReporter("text")})
+			continue
+		}
+		if _, ok := reportFormats[name]; !ok {
+			return nil, fmt.Errorf("알 수 없는 리포터: %s (사용 가능: %s, %s)", name, consoleReporter, strings.Join(reportFormatNames(), ", "))
+		}
+
+		reporter := NewReporter(name)
+		path := *exportPaths[name]
+		if path == "" {
+			path = reporter.DefaultFilename()
+		}
+		targets = append(targets, reportTarget{reporter: reporter, path: path})
+	}
+	return targets, nil
+}
+
+// -template/-text-template으로 지정한 사용자 템플릿을 해당 형식의 리포터에 적용
+// 템플릿 오류는 컬렉션을 실행하기 전에 알린다
+func applyReportTemplates(targets []reportTarget) error {
+	templates := map[string]string{"html": *htmlTmpl, "text": *textTmpl}
+
+	for format, path := range templates {
+		if path == "" {
+			continue
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("템플릿 파일 읽기 실패: %v", err)
+		}
+
+		for _, target := range targets {
+			if target.reporter.format != format {
+				continue
+			}
+			if err := target.reporter.UseTemplate(string(content)); err != nil {
+				return fmt.Errorf("%s: %v", path, err)
+			}
+		}
+	}
+	return nil
+}
+
+// 실행 중에 기록하는 리포트들의 출력 대상을 열고 이벤트 리스너를 반환
+// 표준 출력으로 스트리밍하는 경우 다른 메시지는 표준 에러로 보낸다
+func startLiveReports(targets []reportTarget) (RunListener, func() error, error) {
+	var listeners runListeners
+	var files []*os.File
+
+	closeAll := func() error {
+		var firstErr error
+		for _, f := range files {
+			if err := f.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		return firstErr
+	}
+
+	for i := range targets {
+		target := &targets[i]
+		if !target.reporter.IsLive() {
+			continue
+		}
+		target.live = true
+
+		out := os.Stdout
+		if target.path != "" {
+			f, err := os.Create(target.path)
+			if err != nil {
+				closeAll()
+				return nil, nil, fmt.Errorf("결과 파일 생성 실패: %v", err)
+			}
+			files = append(files, f)
+			out = f
+		} else {
+			console = os.Stderr
+		}
+		listeners = append(listeners, target.reporter.Listen(out))
+	}
+
+	if len(listeners) == 0 {
+		return nil, closeAll, nil
+	}
+	return listeners, closeAll, nil
+}
+
 func findCollectionFiles(dir string) ([]string, error) {
 	var files []string
-	
+
 	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
-		
+
 		if !info.IsDir() && strings.HasSuffix(strings.ToLower(info.Name()), ".json") {
 			files = append(files, path)
 		}
-		
+
 		return nil
 	})
-	
+
 	return files, err
 }
 
@@ -113,35 +393,62 @@ func printOverallSummary(results []*TestSummary) {
 	totalTests := 0
 	totalPassed := 0
 	totalFailed := 0
+	totalSkipped := 0
 	successfulCollections := 0
+	slaFailed := 0 // SLA만 초과하고 기능 검증은 통과한 요청
+	var budgetViolations []string
 
 	for _, result := range results {
 		totalTests += result.TotalTests
 		totalPassed += result.PassedTests
 		totalFailed += result.FailedTests
-		if result.FailedTests == 0 {
+		totalSkipped += result.SkippedTests
+		if result.FailedTests == 0 && len(result.SLAViolations) == 0 {
 			successfulCollections++
 		}
+		for _, test := range result.Results {
+			if test.SLAExceeded && !hasFunctionalFailure(test) {
+				slaFailed++
+			}
+		}
+		for _, violation := range result.SLAViolations {
+			budgetViolations = append(budgetViolations, result.CollectionName+": "+violation)
+		}
 	}
 
-	fmt.Println("=" + strings.Repeat("=", 50))
-	fmt.Println("📋 전체 테스트 요약")
-	fmt.Println("=" + strings.Repeat("=", 50))
-	fmt.Printf("컬렉션: %d개 (성공: %d개)\n", totalCollections, successfulCollections)
-	fmt.Printf("테스트: %d개 (성공: %d개, 실패: %d개)\n", totalTests, totalPassed, totalFailed)
-	
-	if totalFailed > 0 {
-		fmt.Printf("🔴 전체 성공률: %.1f%%\n", float64(totalPassed)/float64(totalTests)*100)
+	fmt.Fprintln(console, "="+strings.Repeat("=", 50))
+	fmt.Fprintln(console, "📋 전체 테스트 요약")
+	fmt.Fprintln(console, "="+strings.Repeat("=", 50))
+	fmt.Fprintf(console, "컬렉션: %d개 (성공: %d개)\n", totalCollections, successfulCollections)
+	fmt.Fprintf(console, "테스트: %d개 (성공: %d개, 실패: %d개)\n", totalTests, totalPassed, totalFailed)
+	if slaFailed > 0 {
+		fmt.Fprintf(console, "  └ 기능 실패: %d개, SLA 초과: %d개\n", totalFailed-slaFailed, slaFailed)
+	}
+	if totalSkipped > 0 {
+		fmt.Fprintf(console, "건너뜀: %d개 (필터에 의해 제외)\n", totalSkipped)
+	}
+	if len(budgetViolations) > 0 {
+		fmt.Fprintf(console, "⏱️ 성능 예산 초과: %d건\n", len(budgetViolations))
+		for _, violation := range budgetViolations {
+			fmt.Fprintf(console, "  - %s\n", violation)
+		}
+	}
+
+	if len(budgetViolations) > 0 && totalFailed == 0 {
+		fmt.Fprintln(console, "🔴 모든 테스트는 성공했지만 성능 예산을 초과했습니다")
+		os.Exit(1)
+	} else if totalFailed > 0 {
+		fmt.Fprintf(console, "🔴 전체 성공률: %.1f%%\n", float64(totalPassed)/float64(totalTests)*100)
 		os.Exit(1)
 	} else {
-		fmt.Println("🟢 모든 테스트가 성공했습니다!")
+		fmt.Fprintln(console, "🟢 모든 테스트가 성공했습니다!")
 	}
 }
 
 // 단일 컬렉션 실행 함수
 func runSingleCollection(runner *Runner, file string, index, total int, verbose bool) *TestSummary {
-	fmt.Printf("[%d/%d] %s 실행 중...\n", index, total, filepath.Base(file))
-	
+	fmt.Fprintf(console, "[%d/%d] %s 실행 중...\n", index, total, filepath.Base(file))
+
 	collection, err := runner.LoadCollection(file)
 	if err != nil {
 		log.Printf("❌ 컬렉션 로드 실패: %s - %v", file, err)
@@ -149,87 +456,101 @@ func runSingleCollection(runner *Runner, file string, index, total int, verbose
 	}
 
 	if verbose {
-		fmt.Printf("  📄 컬렉션: %s\n", collection.Info.Name)
+		fmt.Fprintf(console, "  📄 컬렉션: %s\n", collection.Info.Name)
 	}
 
-	summary := runner.RunCollection(collection)
-	summary.CollectionName = collection.Info.Name
-	summary.FilePath = file
+	summary := runner.RunCollection(collection, file)
 
 	// 간단한 결과 출력
+	if summary.SkippedTests > 0 {
+		fmt.Fprintf(console, "  ⏭️  %d개 건너뜀 (필터)\n", summary.SkippedTests)
+	}
 	if summary.FailedTests > 0 {
-		fmt.Printf("  ❌ %d개 실패 / %d개 총 테스트 (%.2fs)\n", 
+		fmt.Fprintf(console, "  ❌ %d개 실패 / %d개 총 테스트 (%.2fs)\n",
 			summary.FailedTests, summary.TotalTests, summary.TotalTime.Seconds())
 	} else {
-		fmt.Printf("  ✅ %d개 모두 성공 (%.2fs)\n", 
+		fmt.Fprintf(console, "  ✅ %d개 모두 성공 (%.2fs)\n",
 			summary.TotalTests, summary.TotalTime.Seconds())
 	}
-	fmt.Println()
+	if len(summary.SLAViolations) > 0 {
+		fmt.Fprintf(console, "  ⏱️ 성능 예산 초과 %d건\n", len(summary.SLAViolations))
+	}
+	fmt.Fprintln(console)
 
 	return summary
 }
 
 // 병렬 컬렉션 실행 함수
-func runCollectionsInParallel(files []string, maxParallel int, verbose bool) []*TestSummary {
+// 결과는 완료 순서와 무관하게 입력 파일 순서대로 반환되며,
+// 컬렉션별 출력은 버퍼에 모았다가 입력 순서대로 out에 출력한다
+func runCollectionsInParallel(files []string, maxParallel int, verbose bool, out io.Writer, newRunner func() *Runner) []*TestSummary {
 	var wg sync.WaitGroup
-	var mu sync.Mutex
-	results := make([]*TestSummary, 0, len(files))
-	
-	// 작업 채널과 워커 풀 생성
-	jobs := make(chan string, len(files))
-	
+	results := make([]*TestSummary, len(files))
+	display := newProgressDisplay(out, files)
+
+	// 작업 채널과 워커 풀 생성 (파일 인덱스 전달)
+	jobs := make(chan int, len(files))
+
 	// 워커 시작
 	for w := 0; w < maxParallel; w++ {
 		go func() {
-			runner := NewRunner()
-			for file := range jobs {
-				result := processCollectionFile(runner, file, verbose)
-				if result != nil {
-					mu.Lock()
-					results = append(results, result)
-					mu.Unlock()
-				}
+			runner := newRunner()
+			for index := range jobs {
+				var buf bytes.Buffer
+				results[index] = processCollectionFile(runner, files[index], index, display, &buf, verbose)
+				display.Finish(index, buf.String())
 				wg.Done()
 			}
 		}()
 	}
 
 	// 작업 큐에 파일들 추가
-	for _, file := range files {
+	for index := range files {
 		wg.Add(1)
-		jobs <- file
+		jobs <- index
 	}
 	close(jobs)
 
 	// 모든 작업 완료 대기
 	wg.Wait()
-	
-	fmt.Printf("✅ %d개 컬렉션 병렬 실행 완료\n\n", len(results))
-	return results
+	display.Close()
+
+	// 로드에 실패한 컬렉션은 제외하고 입력 순서 유지
+	ordered := make([]*TestSummary, 0, len(files))
+	for _, result := range results {
+		if result != nil {
+			ordered = append(ordered, result)
+		}
+	}
+
+	fmt.Fprintf(out, "✅ %d개 컬렉션 병렬 실행 완료\n\n", len(ordered))
+	return ordered
 }
 
 // 개별 컬렉션 파일 처리 (병렬용)
-func processCollectionFile(runner *Runner, file string, verbose bool) *TestSummary {
+// 다른 워커의 출력과 섞이지 않도록 모든 메시지는 out에 기록한다
+func processCollectionFile(runner *Runner, file string, index int, display *progressDisplay, out io.Writer, verbose bool) *TestSummary {
 	collection, err := runner.LoadCollection(file)
 	if err != nil {
-		log.Printf("❌ 컬렉션 로드 실패: %s - %v", file, err)
+		fmt.Fprintf(out, "❌ 컬렉션 로드 실패: %s - %v\n", file, err)
 		return nil
 	}
 
 	if verbose {
-		fmt.Printf("🔄 처리 중: %s\n", collection.Info.Name)
+		fmt.Fprintf(out, "🔄 처리 중: %s\n", collection.Info.Name)
 	}
 
-	summary := runner.RunCollection(collection)
-	summary.CollectionName = collection.Info.Name
-	summary.FilePath = file
+	display.Start(index, countRequests(collection.Item))
+	runner.onResult = func(TestResult) { display.Advance(index) }
+	summary := runner.RunCollection(collection, file)
+	runner.onResult = nil
 
 	status := "✅"
 	if summary.FailedTests > 0 {
 		status = "❌"
 	}
 
-	fmt.Printf("%s %s: %d/%d 성공 (%.2fs)\n", 
+	fmt.Fprintf(out, "%s %s: %d/%d 성공 (%.2fs)\n",
 		status, filepath.Base(file), summary.PassedTests, summary.TotalTests, summary.TotalTime.Seconds())
 
 	return summary
@@ -249,6 +570,27 @@ func printUsage() {
 	fmt.Printf("  %s -dir ./collections                 # 특정 디렉토리의 컬렉션 실행\n", os.Args[0])
 	fmt.Printf("  %s -file test.json                    # 단일 파일 실행\n", os.Args[0])
 	fmt.Printf("  %s -output report.html -format html   # HTML 리포트 생성\n", os.Args[0])
+	fmt.Printf("  %s -output run.har -format har        # 브라우저 개발자 도구용 HAR 파일 생성\n", os.Args[0])
+	fmt.Printf("  %s -output junit.xml -format junit    # CI용 JUnit XML 리포트 생성\n", os.Args[0])
+	fmt.Printf("  %s -format tap | tap-spec             # TAP 도구로 결과 집계\n", os.Args[0])
+	fmt.Printf("  %s -output summary.md -format markdown # PR 댓글용 Markdown 요약 생성\n", os.Args[0])
+	fmt.Printf("  %s -format csv -csv-delimiter \";\" -csv-rows assertion # 세미콜론 구분, 검증 결과마다 한 행\n", os.Args[0])
+	fmt.Printf("  %s -reporter cli,junit,html -reporter-junit-export out.xml # 콘솔 출력과 JUnit/HTML 파일을 한 번에 생성\n", os.Args[0])
+	fmt.Printf("  %s -format html -template brand.tmpl  # 사용자 템플릿으로 HTML 리포트 생성\n", os.Args[0])
 	fmt.Printf("  %s -parallel 3                        # 3개 컬렉션 동시 실행\n", os.Args[0])
+	fmt.Printf("  %s -assertions checks.yaml            # 상태 코드/헤더/JSON 값 등 선언적 검증 추가\n", os.Args[0])
+	fmt.Printf("  %s -schemas schemas/                  # 응답 본문을 요청 이름별 JSON Schema로 검증\n", os.Args[0])
+	fmt.Printf("  %s -snapshot-dir snaps -snapshot-ignore '$..id'  # 응답 본문 스냅샷 비교\n", os.Args[0])
+	fmt.Printf("  %s -verify-examples                   # 응답을 컬렉션에 저장된 예제와 비교\n", os.Args[0])
+	fmt.Printf("  %s -openapi openapi.yaml              # 요청/응답을 OpenAPI 명세와 대조\n", os.Args[0])
+	fmt.Printf("  %s -openapi openapi.yaml -reporter cli,coverage  # API 커버리지 리포트 저장\n", os.Args[0])
+	fmt.Printf("  %s -max-response-time 500ms -sla sla.yaml  # 응답 시간 SLA와 p95 예산 검사\n", os.Args[0])
+	fmt.Printf("  %s -format json -max-body-capture 64KB  # 리포트의 응답 본문을 64KB까지만 기록\n", os.Args[0])
+	fmt.Printf("  %s -environment dev.json -redact '$..token'  # 환경 파일 사용, 응답의 token 필드 마스킹\n", os.Args[0])
 	fmt.Printf("  %s -verbose                           # 상세 출력\n", os.Args[0])
-}
\ No newline at end of file
+	fmt.Printf("  %s -grep \"^Create\"                    # 이름이 Create로 시작하는 요청만 실행\n", os.Args[0])
+	fmt.Printf("  %s -tag smoke -exclude-tag destructive # @smoke 요청만 실행, @destructive 제외\n", os.Args[0])
+	fmt.Printf("  %s -dry-run -format json              # 요청을 보내지 않고 해석 결과만 확인\n", os.Args[0])
+	fmt.Printf("  %s -curl -curl-shell powershell       # 각 요청을 PowerShell용 curl 명령으로 출력\n", os.Args[0])
+	fmt.Printf("  %s -format ndjson | jq .              # 실행 이벤트를 한 줄씩 실시간으로 출력\n", os.Args[0])
+}
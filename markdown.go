@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PR 댓글 길이 제한(GitHub 65,536자)보다 여유 있게 잡은 최대 길이
+const markdownMaxLength = 60000
+
+// 실패 항목에 포함할 응답 본문의 최대 길이 (글자 수)
+const markdownBodyLimit = 500
+
+func init() {
+	registerReportFormat("markdown", func() ReportGenerator { return markdownReport{} })
+}
+
+type markdownReport struct{}
+
+func (markdownReport) Extension() string { return "md" }
+
+// PR 댓글이나 GitHub Actions 작업 요약($GITHUB_STEP_SUMMARY)에 붙이기 좋은 요약 리포트
+// 길이 제한을 넘으면 실패 목록을 뒤에서부터 생략한다
+func (markdownReport) Generate(summaries []*TestSummary) (string, error) {
+	data := newReportData(summaries)
+	var sb strings.Builder
+
+	status := "✅"
+	if data.Totals.Failed > 0 {
+		status = "❌"
+	}
+	sb.WriteString(fmt.Sprintf("## %s API 테스트 결과\n\n", status))
+	if data.Environment.DryRun {
+		sb.WriteString("> 🔍 드라이런: 요청을 전송하지 않고 해석만 했습니다\n\n")
+	}
+
+	sb.WriteString("| 컬렉션 | 성공 | 실패 | 건너뜀 | 시간 | p50 | p95 | p99 |\n")
+	sb.WriteString("|--------|-----:|-----:|-------:|-----:|----:|----:|----:|\n")
+	for _, summary := range summaries {
+		mark := "✅"
+		if summary.FailedTests > 0 {
+			mark = "❌"
+		}
+		p50, p95, p99 := "-", "-", "-"
+		if summary.Latency != nil {
+			p50 = formatDuration(summary.Latency.Overall.P50)
+			p95 = formatDuration(summary.Latency.Overall.P95)
+			p99 = formatDuration(summary.Latency.Overall.P99)
+		}
+		sb.WriteString(fmt.Sprintf("| %s %s | %d | %d | %d | %s | %s | %s | %s |\n",
+			mark, markdownCell(summary.CollectionName), summary.PassedTests, summary.FailedTests,
+			summary.SkippedTests, formatDuration(summary.TotalTime), p50, p95, p99))
+	}
+	sb.WriteString("\n")
+
+	totals := fmt.Sprintf("**합계:** %d개 컬렉션, %d개 테스트 — %d개 성공, %d개 실패",
+		data.Totals.Collections, data.Totals.Tests, data.Totals.Passed, data.Totals.Failed)
+	if data.Totals.Skipped > 0 {
+		totals += fmt.Sprintf(", %d개 건너뜀", data.Totals.Skipped)
+	}
+	totals += fmt.Sprintf(" (성공률 %.1f%%, %s)\n", data.Totals.SuccessRate, formatDuration(data.Timings.TotalTime))
+
+	var failures []ReportResult
+	for _, result := range data.Results {
+		if !result.Success && !result.Skipped {
+			failures = append(failures, result)
+		}
+	}
+
+	if len(failures) > 0 {
+		sb.WriteString(fmt.Sprintf("<details>\n<summary>❌ 실패한 요청 %d개</summary>\n\n", len(failures)))
+
+		// 닫는 태그와 합계 줄이 들어갈 자리는 남겨둔다
+		budget := markdownMaxLength - sb.Len() - len(totals) - 200
+		for i, failure := range failures {
+			entry := markdownFailure(failure)
+			if len(entry) > budget {
+				sb.WriteString(fmt.Sprintf("_…길이 제한으로 실패 %d개 생략_\n\n", len(failures)-i))
+				break
+			}
+			sb.WriteString(entry)
+			budget -= len(entry)
+		}
+
+		sb.WriteString("</details>\n\n")
+	}
+
+	// 요청별 응답 시간 표는 길이 제한 안에 들어갈 때만 추가
+	if latency := markdownLatency(summaries); latency != "" && sb.Len()+len(latency)+len(totals) <= markdownMaxLength {
+		sb.WriteString(latency)
+	}
+
+	sb.WriteString(totals)
+	return sb.String(), nil
+}
+
+// 요청별 응답 시간 통계 표 (텍스트/HTML 리포트와 같은 "폴더 경로/요청 이름" 표기, 통계가 없으면 빈 문자열)
+func markdownLatency(summaries []*TestSummary) string {
+	var rows strings.Builder
+	for _, summary := range summaries {
+		if summary.Latency == nil {
+			continue
+		}
+		for _, group := range summary.Latency.Requests {
+			rows.WriteString(fmt.Sprintf("| %s | %s | %d | %s | %s | %s |\n",
+				markdownCell(summary.CollectionName), markdownCell(group.Name), group.Count,
+				formatDuration(group.P50), formatDuration(group.P95), formatDuration(group.P99)))
+		}
+	}
+	if rows.Len() == 0 {
+		return ""
+	}
+	return "<details>\n<summary>⏱️ 요청별 응답 시간</summary>\n\n" +
+		"| 컬렉션 | 요청 | n | p50 | p95 | p99 |\n" +
+		"|--------|------|--:|----:|----:|----:|\n" +
+		rows.String() + "\n</details>\n\n"
+}
+
+// 실패한 요청 하나에 대한 항목 (상태 코드, 오류 메시지, 잘린 응답 본문)
+func markdownFailure(result ReportResult) string {
+	var sb strings.Builder
+
+	name := result.Collection + " / "
+	if result.Folder != "" {
+		name += result.Folder + " / "
+	}
+	name += result.Name
+
+	sb.WriteString(fmt.Sprintf("#### %s\n\n", name))
+	sb.WriteString(fmt.Sprintf("`%s %s`", result.Method, result.URL))
+	if result.StatusCode != 0 {
+		sb.WriteString(fmt.Sprintf(" → HTTP %d", result.StatusCode))
+	}
+	sb.WriteString("\n\n")
+	if countAssertions(result.TestResult, false) > 1 {
+		for _, assertion := range result.Assertions {
+			if !assertion.Passed {
+				sb.WriteString(fmt.Sprintf("- ✘ %s — %s\n", assertion.Name, assertion.Message))
+			}
+		}
+		sb.WriteString("\n")
+	} else if result.ErrorMessage != "" {
+		sb.WriteString(fmt.Sprintf("**오류:** %s\n\n", result.ErrorMessage))
+	}
+
+	if body := strings.TrimSpace(result.ResponseBody); body != "" {
+		fence := "```"
+		if strings.Contains(body, fence) {
+			fence = "````"
+		}
+		sb.WriteString(fence + "\n" + truncate(markdownBodyLimit, body) + "\n" + fence + "\n\n")
+	} else if result.BodyBinary {
+		sb.WriteString(fmt.Sprintf("바이너리 본문 %s (sha256 `%s`)\n\n", formatBytes(result.BodySize), result.BodySHA256))
+	}
+	return sb.String()
+}
+
+// 표 안에서 열 구분자나 줄바꿈이 깨지지 않도록 정리
+func markdownCell(text string) string {
+	text = strings.ReplaceAll(text, "|", "\\|")
+	return strings.Join(strings.Fields(text), " ")
+}
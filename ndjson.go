@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// NDJSON 이벤트 스키마 버전 (필드 의미가 바뀌거나 필드가 제거될 때만 올린다)
+const eventSchemaVersion = 1
+
+// 이벤트 종류
+const (
+	eventRunStart        = "run.start"
+	eventCollectionStart = "collection.start"
+	eventRequestStart    = "request.start"
+	eventRequestSkipped  = "request.skipped"
+	eventResponse        = "response"
+	eventAssertion       = "assertion"
+	eventCollectionEnd   = "collection.end"
+	eventRunEnd          = "run.end"
+)
+
+// NDJSON 한 줄에 해당하는 이벤트
+// 공통 필드 외의 내용은 이벤트 종류별로 data에 담긴다
+type runEvent struct {
+	SchemaVersion int         `json:"schema_version"`
+	Seq           int         `json:"seq"` // 스트림 내 순번 (1부터 시작)
+	Type          string      `json:"type"`
+	Timestamp     string      `json:"timestamp"`
+	Collection    string      `json:"collection,omitempty"`
+	File          string      `json:"file,omitempty"`
+	Request       string      `json:"request,omitempty"`
+	Folder        string      `json:"folder,omitempty"`
+	Data          interface{} `json:"data,omitempty"`
+}
+
+type runStartData struct {
+	Files  []string `json:"files"`
+	DryRun bool     `json:"dry_run"`
+}
+
+type collectionStartData struct {
+	TotalRequests int `json:"total_requests"`
+}
+
+type requestStartData struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+type requestSkippedData struct {
+	Reason string `json:"reason"`
+}
+
+type responseData struct {
+	Method         string       `json:"method"`
+	URL            string       `json:"url"`
+	StatusCode     int          `json:"status_code"`
+	ResponseTimeMs float64      `json:"response_time_ms"`
+	Success        bool         `json:"success"`
+	Error          string       `json:"error,omitempty"`
+	Timings        *timingsData `json:"timings,omitempty"` // 요청을 전송한 경우에만 포함
+}
+
+type timingsData struct {
+	BlockedMs        float64 `json:"blocked_ms"`
+	DNSMs            float64 `json:"dns_ms"`
+	ConnectMs        float64 `json:"connect_ms"`
+	TLSMs            float64 `json:"tls_ms"`
+	SendMs           float64 `json:"send_ms"`
+	TTFBMs           float64 `json:"ttfb_ms"`
+	DownloadMs       float64 `json:"download_ms"`
+	ConnectionReused bool    `json:"connection_reused"`
+}
+
+type assertionData struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message,omitempty"`
+}
+
+type collectionEndData struct {
+	Total       int          `json:"total"`
+	Passed      int          `json:"passed"`
+	Failed      int          `json:"failed"`
+	Skipped     int          `json:"skipped"`
+	TotalTimeMs float64      `json:"total_time_ms"`
+	Latency     *latencyData `json:"latency,omitempty"` // 응답을 받은 요청이 없으면 생략
+}
+
+type latencyData struct {
+	Count    int     `json:"count"`
+	MinMs    float64 `json:"min_ms"`
+	MaxMs    float64 `json:"max_ms"`
+	MeanMs   float64 `json:"mean_ms"`
+	StdDevMs float64 `json:"stddev_ms"`
+	P50Ms    float64 `json:"p50_ms"`
+	P90Ms    float64 `json:"p90_ms"`
+	P95Ms    float64 `json:"p95_ms"`
+	P99Ms    float64 `json:"p99_ms"`
+}
+
+type runEndData struct {
+	Collections   int  `json:"collections"`
+	Total         int  `json:"total"`
+	Passed        int  `json:"passed"`
+	Failed        int  `json:"failed"`
+	Skipped       int  `json:"skipped"`
+	SLAViolations int  `json:"sla_violations,omitempty"` // 성능 예산 위반 수 (-sla의 budgets)
+	Success       bool `json:"success"`                  // 종료 코드 0과 같은 의미 (실패한 요청과 예산 위반이 없음)
+}
+
+func init() {
+	registerReportFormat("ndjson", func() ReportGenerator { return ndjsonReport{} })
+}
+
+type ndjsonReport struct{}
+
+func (ndjsonReport) Extension() string { return "ndjson" }
+
+func (ndjsonReport) Listen(w io.Writer) RunListener {
+	return newEventStream(w)
+}
+
+// 실행이 끝난 결과로부터 이벤트 스트림을 재구성 (실시간 출력은 Listen 사용)
+func (ndjsonReport) Generate(summaries []*TestSummary) (string, error) {
+	var buf bytes.Buffer
+	stream := newEventStream(&buf)
+
+	files := make([]string, len(summaries))
+	for i, summary := range summaries {
+		files[i] = summary.FilePath
+	}
+	if len(summaries) > 0 {
+		stream.runStarted(summaries[0].StartTime, files, summaries[0].DryRun)
+	}
+
+	for _, summary := range summaries {
+		stream.collectionStarted(summary.StartTime, summary.CollectionName, summary.FilePath, len(summary.Results))
+		for _, result := range summary.Results {
+			if !result.Skipped {
+				stream.requestStarted(result.Timestamp, summary.CollectionName, summary.FilePath, result.Name, result.Folder, result.Method, result.URL)
+			}
+			stream.requestFinished(result.Timestamp.Add(result.ResponseTime), summary.CollectionName, summary.FilePath, result)
+		}
+		stream.collectionFinished(summary.EndTime, summary)
+	}
+
+	if len(summaries) > 0 {
+		stream.runFinished(summaries[len(summaries)-1].EndTime, summaries)
+	}
+	return buf.String(), stream.err
+}
+
+// 이벤트를 한 줄씩 즉시 기록하는 스트림 (여러 워커에서 동시에 사용 가능)
+type eventStream struct {
+	mu  sync.Mutex
+	w   io.Writer
+	seq int
+	err error // 처음 발생한 쓰기 오류
+}
+
+func newEventStream(w io.Writer) *eventStream {
+	return &eventStream{w: w}
+}
+
+// 이벤트 한 줄을 한 번의 Write로 기록해 다른 워커의 출력과 섞이지 않게 한다
+func (s *eventStream) emit(at time.Time, event runEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.seq++
+	event.SchemaVersion = eventSchemaVersion
+	event.Seq = s.seq
+	event.Timestamp = at.Format(time.RFC3339Nano)
+
+	line, err := json.Marshal(event)
+	if err == nil {
+		_, err = s.w.Write(append(line, '\n'))
+	}
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+}
+
+func (s *eventStream) RunStarted(files []string, dryRun bool) {
+	s.runStarted(time.Now(), files, dryRun)
+}
+
+func (s *eventStream) CollectionStarted(collection, file string, totalRequests int) {
+	s.collectionStarted(time.Now(), collection, file, totalRequests)
+}
+
+func (s *eventStream) RequestStarted(collection, file string, request TestResult) {
+	s.requestStarted(time.Now(), collection, file, request.Name, request.Folder, request.Method, request.URL)
+}
+
+func (s *eventStream) RequestFinished(collection, file string, result TestResult) {
+	s.requestFinished(time.Now(), collection, file, result)
+}
+
+func (s *eventStream) CollectionFinished(summary *TestSummary) {
+	s.collectionFinished(time.Now(), summary)
+}
+
+func (s *eventStream) RunFinished(summaries []*TestSummary) {
+	s.runFinished(time.Now(), summaries)
+}
+
+func (s *eventStream) runStarted(at time.Time, files []string, dryRun bool) {
+	s.emit(at, runEvent{Type: eventRunStart, Data: runStartData{Files: files, DryRun: dryRun}})
+}
+
+func (s *eventStream) collectionStarted(at time.Time, collection, file string, totalRequests int) {
+	s.emit(at, runEvent{
+		Type:       eventCollectionStart,
+		Collection: collection,
+		File:       file,
+		Data:       collectionStartData{TotalRequests: totalRequests},
+	})
+}
+
+func (s *eventStream) requestStarted(at time.Time, collection, file, name, folder, method, url string) {
+	s.emit(at, runEvent{
+		Type:       eventRequestStart,
+		Collection: collection,
+		File:       file,
+		Request:    name,
+		Folder:     folder,
+		Data:       requestStartData{Method: method, URL: url},
+	})
+}
+
+// 응답 이벤트와 검증 결과별 assertion 이벤트 기록 (건너뛴 요청은 request.skipped)
+func (s *eventStream) requestFinished(at time.Time, collection, file string, result TestResult) {
+	base := runEvent{Collection: collection, File: file, Request: result.Name, Folder: result.Folder}
+
+	if result.Skipped {
+		event := base
+		event.Type = eventRequestSkipped
+		event.Data = requestSkippedData{Reason: result.SkipReason}
+		s.emit(at, event)
+		return
+	}
+
+	event := base
+	event.Type = eventResponse
+	event.Data = responseData{
+		Method:         result.Method,
+		URL:            result.URL,
+		StatusCode:     result.StatusCode,
+		ResponseTimeMs: milliseconds(result.ResponseTime),
+		Success:        result.Success,
+		Error:          result.ErrorMessage,
+		Timings:        newTimingsData(result.Timings),
+	}
+	s.emit(at, event)
+
+	for _, assertion := range result.Assertions {
+		event := base
+		event.Type = eventAssertion
+		event.Data = assertionData{Name: assertion.Name, Passed: assertion.Passed, Message: assertion.Message}
+		s.emit(at, event)
+	}
+}
+
+func (s *eventStream) collectionFinished(at time.Time, summary *TestSummary) {
+	data := collectionEndData{
+		Total:       summary.TotalTests,
+		Passed:      summary.PassedTests,
+		Failed:      summary.FailedTests,
+		Skipped:     summary.SkippedTests,
+		TotalTimeMs: milliseconds(summary.TotalTime),
+	}
+	if summary.Latency != nil {
+		stats := summary.Latency.Overall
+		data.Latency = &latencyData{
+			Count:    stats.Count,
+			MinMs:    milliseconds(stats.Min),
+			MaxMs:    milliseconds(stats.Max),
+			MeanMs:   milliseconds(stats.Mean),
+			StdDevMs: milliseconds(stats.StdDev),
+			P50Ms:    milliseconds(stats.P50),
+			P90Ms:    milliseconds(stats.P90),
+			P95Ms:    milliseconds(stats.P95),
+			P99Ms:    milliseconds(stats.P99),
+		}
+	}
+
+	s.emit(at, runEvent{
+		Type:       eventCollectionEnd,
+		Collection: summary.CollectionName,
+		File:       summary.FilePath,
+		Data:       data,
+	})
+}
+
+func (s *eventStream) runFinished(at time.Time, summaries []*TestSummary) {
+	data := runEndData{Collections: len(summaries)}
+	for _, summary := range summaries {
+		data.Total += summary.TotalTests
+		data.Passed += summary.PassedTests
+		data.Failed += summary.FailedTests
+		data.Skipped += summary.SkippedTests
+		data.SLAViolations += len(summary.SLAViolations)
+	}
+	data.Success = data.Failed == 0 && data.SLAViolations == 0
+	s.emit(at, runEvent{Type: eventRunEnd, Data: data})
+}
+
+func newTimingsData(t *RequestTimings) *timingsData {
+	if t == nil {
+		return nil
+	}
+	return &timingsData{
+		BlockedMs:        milliseconds(t.Blocked),
+		DNSMs:            milliseconds(t.DNS),
+		ConnectMs:        milliseconds(t.Connect),
+		TLSMs:            milliseconds(t.TLS),
+		SendMs:           milliseconds(t.Send),
+		TTFBMs:           milliseconds(t.TTFB),
+		DownloadMs:       milliseconds(t.Download),
+		ConnectionReused: t.ConnectionReused,
+	}
+}
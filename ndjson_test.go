@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// 이벤트 스키마는 CI 도구가 읽으므로 한 줄씩 고정해 비교한다
+func TestNDJSONReportGolden(t *testing.T) {
+	got, err := ndjsonReport{}.Generate(reportTestSummaries())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const (
+		users  = `"collection":"Users API","file":"collections/users.json"`
+		create = users + `,"request":"Create \u003cuser\u003e \u0026 \"admin\"","folder":"Users"`
+		list   = users + `,"request":"List users","folder":"Users/Admin"`
+	)
+	want := []string{
+		`{"schema_version":1,"seq":1,"type":"run.start","timestamp":"2024-03-01T09:30:00Z","data":{"files":["collections/users.json"],"dry_run":false}}`,
+		`{"schema_version":1,"seq":2,"type":"collection.start","timestamp":"2024-03-01T09:30:00Z",` + users + `,"data":{"total_requests":4}}`,
+		`{"schema_version":1,"seq":3,"type":"request.start","timestamp":"2024-03-01T09:30:00Z",` + list + `,"data":{"method":"GET","url":"https://api.example.com/users"}}`,
+		`{"schema_version":1,"seq":4,"type":"response","timestamp":"2024-03-01T09:30:00.12Z",` + list + `,"data":{"method":"GET","url":"https://api.example.com/users","status_code":200,"response_time_ms":120,"success":true}}`,
+		`{"schema_version":1,"seq":5,"type":"assertion","timestamp":"2024-03-01T09:30:00.12Z",` + list + `,"data":{"name":"상태 코드 2xx","passed":true}}`,
+		`{"schema_version":1,"seq":6,"type":"request.start","timestamp":"2024-03-01T09:30:00.2Z",` + create + `,"data":{"method":"POST","url":"https://api.example.com/users?role=a\u0026b"}}`,
+		`{"schema_version":1,"seq":7,"type":"response","timestamp":"2024-03-01T09:30:00.28Z",` + create + `,"data":{"method":"POST","url":"https://api.example.com/users?role=a\u0026b","status_code":422,"response_time_ms":80,"success":false,"error":"검증 실패",` +
+			`"timings":{"blocked_ms":0,"dns_ms":2,"connect_ms":5,"tls_ms":0,"send_ms":0,"ttfb_ms":70,"download_ms":3,"connection_reused":false}}}`,
+		`{"schema_version":1,"seq":8,"type":"assertion","timestamp":"2024-03-01T09:30:00.28Z",` + create + `,"data":{"name":"상태 코드 2xx","passed":false,"message":"예상 2xx, 실제 422"}}`,
+		`{"schema_version":1,"seq":9,"type":"assertion","timestamp":"2024-03-01T09:30:00.28Z",` + create + `,"data":{"name":"응답 시간","passed":true}}`,
+		`{"schema_version":1,"seq":10,"type":"request.start","timestamp":"2024-03-01T09:30:00.4Z",` + users + `,"request":"Health","data":{"method":"GET","url":"https://down.example.com/health"}}`,
+		`{"schema_version":1,"seq":11,"type":"response","timestamp":"2024-03-01T09:30:00.4Z",` + users + `,"request":"Health","data":{"method":"GET","url":"https://down.example.com/health","status_code":0,"response_time_ms":0,"success":false,"error":"요청 실패: connection refused"}}`,
+		`{"schema_version":1,"seq":12,"type":"request.skipped","timestamp":"2024-03-01T09:30:00.5Z",` + users + `,"request":"Delete user","folder":"Users","data":{"reason":"제외 태그 @destructive"}}`,
+		`{"schema_version":1,"seq":13,"type":"collection.end","timestamp":"2024-03-01T09:30:01.5Z",` + users + `,"data":{"total":4,"passed":1,"failed":2,"skipped":1,"total_time_ms":1500,` +
+			`"latency":{"count":2,"min_ms":80,"max_ms":120,"mean_ms":100,"stddev_ms":20,"p50_ms":80,"p90_ms":120,"p95_ms":120,"p99_ms":120}}}`,
+		`{"schema_version":1,"seq":14,"type":"run.end","timestamp":"2024-03-01T09:30:01.5Z","data":{"collections":1,"total":4,"passed":1,"failed":2,"skipped":1,"success":false}}`,
+	}
+
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	if len(lines) != len(want) {
+		t.Fatalf("got %d events, want %d:\n%s", len(lines), len(want), got)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("event %d:\n got  %s\n want %s", i+1, lines[i], want[i])
+		}
+	}
+}
+
+func TestNDJSONReportEmpty(t *testing.T) {
+	got, err := ndjsonReport{}.Generate(nil)
+	if got != "" || err != nil {
+		t.Errorf("empty NDJSON = %q, %v; want no events", got, err)
+	}
+}
+
+// 여러 워커가 동시에 기록해도 줄이 섞이지 않고 순번이 빠짐없이 이어져야 한다
+func TestEventStreamConcurrentWorkers(t *testing.T) {
+	var buf strings.Builder
+	stream := newEventStream(&buf)
+
+	const workers, requests = 8, 50
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < requests; i++ {
+				stream.RequestStarted("c", "c.json", TestResult{Name: "r", Method: "GET", URL: "https://x/"})
+			}
+		}()
+	}
+	wg.Wait()
+
+	seen := make(map[int]bool)
+	scanner := bufio.NewScanner(strings.NewReader(buf.String()))
+	for scanner.Scan() {
+		var event runEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("broken line %q: %v", scanner.Text(), err)
+		}
+		if event.Type != eventRequestStart || seen[event.Seq] {
+			t.Errorf("unexpected event: %s", scanner.Text())
+		}
+		seen[event.Seq] = true
+	}
+	for seq := 1; seq <= workers*requests; seq++ {
+		if !seen[seq] {
+			t.Fatalf("missing seq %d (got %d events)", seq, len(seen))
+		}
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestEventStreamKeepsFirstWriteError(t *testing.T) {
+	stream := newEventStream(failingWriter{})
+	stream.RunStarted([]string{"a.json"}, false)
+	stream.RunFinished(nil)
+	if stream.err == nil || stream.err.Error() != "disk full" {
+		t.Errorf("stream error = %v, want disk full", stream.err)
+	}
+}
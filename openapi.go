@@ -0,0 +1,621 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"gopkg.in/yaml.v3"
+)
+
+// 검증 메시지에 포함할 최대 위반 개수 (전체 목록은 TestResult.ContractViolations에 기록)
+const maxContractViolationsInMessage = 5
+
+var openAPIMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// 서버 URL의 {변수}
+var serverVariablePattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// OpenAPI 3.x 명세 (-openapi)
+// 실행한 요청을 메서드와 경로로 오퍼레이션에 대응시키고 파라미터, 요청 본문, 상태 코드, 응답 본문을 검증한다
+type openAPISpec struct {
+	location   string // 스키마 컴파일에 쓰는 명세 파일 URL
+	doc        map[string]interface{}
+	basePaths  []string // servers의 경로 부분 (긴 것부터)
+	operations []*openAPIOperation
+	compiler   *schemaCompiler
+}
+
+// 경로와 메서드로 정해지는 오퍼레이션 하나
+type openAPIOperation struct {
+	Method   string // 대문자 (예: GET)
+	Path     string // 명세의 경로 템플릿 (예: /users/{id})
+	segments []string
+	pointer  string // 명세 내 JSON 포인터 (예: /paths/~1users~1{id}/get)
+	node     map[string]interface{}
+	params   []openAPIParameter
+}
+
+type openAPIParameter struct {
+	name     string
+	in       string // path, query, header, cookie
+	required bool
+	schema   string // 스키마의 JSON 포인터 (없으면 빈 문자열)
+}
+
+// 명세 파일 로드 (YAML 또는 JSON, 외부 파일 $ref는 명세 파일 기준 상대 경로)
+func loadOpenAPISpec(path string) (*openAPISpec, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	loader := openAPILoader{}
+	location := "file://" + filepath.ToSlash(abs)
+	if !strings.HasPrefix(location, "file:///") {
+		location = "file:///" + strings.TrimPrefix(location, "file://") // Windows 드라이브 경로
+	}
+
+	document, err := loader.Load(location)
+	if err != nil {
+		return nil, fmt.Errorf("OpenAPI 명세 읽기 실패: %v", err)
+	}
+	doc, ok := document.(map[string]interface{})
+	version, _ := doc["openapi"].(string)
+	if !ok || !strings.HasPrefix(version, "3.") {
+		return nil, fmt.Errorf("OpenAPI 3.x 명세가 아닙니다: %s", path)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.UseLoader(jsonschema.SchemeURLLoader{"file": loader})
+	if strings.HasPrefix(version, "3.0") {
+		compiler.DefaultDraft(jsonschema.Draft4) // 3.0의 스키마 객체는 draft-04 기반
+	} else {
+		compiler.DefaultDraft(jsonschema.Draft2020)
+	}
+
+	spec := &openAPISpec{
+		location: location,
+		doc:      doc,
+		compiler: &schemaCompiler{compiler: compiler, cache: make(map[string]*jsonschema.Schema)},
+	}
+	spec.basePaths = serverBasePaths(doc)
+
+	paths, _ := doc["paths"].(map[string]interface{})
+	for _, template := range sortedMapKeys(paths) {
+		pathPointer := "/paths/" + escapePointer(template)
+		pathItem, pathItemPointer := spec.resolve(paths[template], pathPointer)
+		item, _ := pathItem.(map[string]interface{})
+		if item == nil {
+			continue
+		}
+		shared := spec.parameters(item["parameters"], pathItemPointer+"/parameters")
+
+		for _, method := range openAPIMethods {
+			node, ok := item[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			operation := &openAPIOperation{
+				Method:   strings.ToUpper(method),
+				Path:     template,
+				segments: strings.Split(strings.Trim(template, "/"), "/"),
+				pointer:  pathItemPointer + "/" + method,
+				node:     node,
+			}
+			// 오퍼레이션에 같은 이름/위치의 파라미터가 있으면 경로 공통 파라미터를 덮어쓴다
+			own := spec.parameters(node["parameters"], operation.pointer+"/parameters")
+			for _, param := range shared {
+				if !hasParameter(own, param) {
+					operation.params = append(operation.params, param)
+				}
+			}
+			operation.params = append(operation.params, own...)
+			spec.operations = append(spec.operations, operation)
+		}
+	}
+	return spec, nil
+}
+
+// servers[].url의 경로 부분 ({변수}는 default 값으로 치환)
+func serverBasePaths(doc map[string]interface{}) []string {
+	basePaths := []string{""}
+	servers, _ := doc["servers"].([]interface{})
+	for _, entry := range servers {
+		server, _ := entry.(map[string]interface{})
+		raw, _ := server["url"].(string)
+		variables, _ := server["variables"].(map[string]interface{})
+		raw = serverVariablePattern.ReplaceAllStringFunc(raw, func(match string) string {
+			variable, _ := variables[match[1:len(match)-1]].(map[string]interface{})
+			value, _ := variable["default"].(string)
+			return value
+		})
+		parsed, err := url.Parse(raw)
+		if err != nil {
+			continue
+		}
+		if base := strings.TrimRight(parsed.Path, "/"); base != "" && !containsString(basePaths, base) {
+			basePaths = append(basePaths, base)
+		}
+	}
+	sort.Slice(basePaths, func(i, j int) bool { return len(basePaths[i]) > len(basePaths[j]) })
+	return basePaths
+}
+
+// 파라미터 목록 해석 ($ref 포함)
+func (s *openAPISpec) parameters(value interface{}, pointer string) []openAPIParameter {
+	var params []openAPIParameter
+	list, _ := value.([]interface{})
+	for i, entry := range list {
+		resolved, paramPointer := s.resolve(entry, fmt.Sprintf("%s/%d", pointer, i))
+		node, ok := resolved.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		param := openAPIParameter{}
+		param.name, _ = node["name"].(string)
+		param.in, _ = node["in"].(string)
+		param.required, _ = node["required"].(bool)
+		if _, ok := node["schema"]; ok {
+			param.schema = paramPointer + "/schema"
+		}
+		params = append(params, param)
+	}
+	return params
+}
+
+func hasParameter(params []openAPIParameter, param openAPIParameter) bool {
+	for _, p := range params {
+		if p.in == param.in && strings.EqualFold(p.name, param.name) {
+			return true
+		}
+	}
+	return false
+}
+
+// 명세 내부 $ref("#/...")를 따라간 값과 그 위치 (외부 파일 참조는 따라가지 않음)
+func (s *openAPISpec) resolve(value interface{}, pointer string) (interface{}, string) {
+	for depth := 0; depth < 32; depth++ {
+		node, ok := value.(map[string]interface{})
+		if !ok {
+			break
+		}
+		ref, ok := node["$ref"].(string)
+		if !ok || !strings.HasPrefix(ref, "#/") {
+			break
+		}
+		pointer = ref[1:]
+		value = s.lookupPointer(pointer)
+	}
+	return value, pointer
+}
+
+func (s *openAPISpec) lookupPointer(pointer string) interface{} {
+	var current interface{} = s.doc
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch v := current.(type) {
+		case map[string]interface{}:
+			current = v[token]
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil
+			}
+			current = v[index]
+		default:
+			return nil
+		}
+	}
+	return current
+}
+
+// 명세 내 위치의 스키마 컴파일 (같은 위치는 한 번만 컴파일)
+func (s *openAPISpec) schema(pointer string) (*jsonschema.Schema, error) {
+	var fragment strings.Builder
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		fragment.WriteString("/" + url.PathEscape(token))
+	}
+	return s.compiler.compileLocation(s.location + "#" + fragment.String())
+}
+
+// 요청 경로와 메서드에 맞는 오퍼레이션 (경로만 일치하면 nil과 그 경로에 정의된 메서드 목록 반환)
+func (s *openAPISpec) match(method, requestPath string) (*openAPIOperation, map[string]string, []string) {
+	var best *openAPIOperation
+	var bestParams map[string]string
+	bestLiterals := -1
+	var methods []string
+
+	for _, base := range s.basePaths {
+		if base != "" && requestPath != base && !strings.HasPrefix(requestPath, base+"/") {
+			continue
+		}
+		segments := strings.Split(strings.Trim(strings.TrimPrefix(requestPath, base), "/"), "/")
+		for _, operation := range s.operations {
+			params, literals, ok := matchPathTemplate(operation.segments, segments)
+			if !ok {
+				continue
+			}
+			if operation.Method != method {
+				if !containsString(methods, operation.Method) {
+					methods = append(methods, operation.Method)
+				}
+				continue
+			}
+			// {변수}보다 고정 경로가 많이 일치하는 오퍼레이션 우선 (/users/me가 /users/{id}보다 먼저)
+			if literals > bestLiterals {
+				best, bestParams, bestLiterals = operation, params, literals
+			}
+		}
+		if best != nil {
+			return best, bestParams, nil
+		}
+	}
+	return nil, nil, methods
+}
+
+// 경로 템플릿 일치 여부와 경로 변수 값, 고정 세그먼트 개수
+func matchPathTemplate(template, segments []string) (map[string]string, int, bool) {
+	if len(template) != len(segments) {
+		return nil, 0, false
+	}
+	params := make(map[string]string)
+	literals := 0
+	for i, part := range template {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if segments[i] == "" {
+				return nil, 0, false
+			}
+			value, err := url.PathUnescape(segments[i])
+			if err != nil {
+				value = segments[i]
+			}
+			params[part[1:len(part)-1]] = value
+			continue
+		}
+		if part != segments[i] {
+			return nil, 0, false
+		}
+		literals++
+	}
+	return params, literals, true
+}
+
+// 요청 하나를 명세와 대조해 결과 추가 (nil이면 아무것도 하지 않음)
+func (s *openAPISpec) evaluate(result *TestResult) {
+	if s == nil {
+		return
+	}
+
+	parsed, err := url.Parse(result.URL)
+	if err != nil {
+		return
+	}
+	method := strings.ToUpper(result.Method)
+	operation, pathParams, methods := s.match(method, parsed.Path)
+	if operation == nil {
+		message := fmt.Sprintf("일치하는 OpenAPI 오퍼레이션 없음: %s %s", method, parsed.Path)
+		if len(methods) > 0 {
+			message += fmt.Sprintf(" (정의된 메서드: %s)", strings.Join(methods, ", "))
+		}
+		result.ContractViolations = append(result.ContractViolations, message)
+		result.Assertions = append(result.Assertions, AssertionResult{Name: "OpenAPI 오퍼레이션", Message: message})
+		return
+	}
+	result.Operation = operation.Method + " " + operation.Path
+
+	var violations []string
+	violations = append(violations, s.checkParameters(operation, pathParams, parsed.Query(), result)...)
+	violations = append(violations, s.checkRequestBody(operation, result)...)
+	violations = append(violations, s.checkResponse(operation, result)...)
+
+	name := "OpenAPI " + result.Operation
+	if len(violations) == 0 {
+		result.Assertions = append(result.Assertions, AssertionResult{Name: name, Passed: true})
+		return
+	}
+	result.ContractViolations = append(result.ContractViolations, violations...)
+	messages := violations
+	if len(messages) > maxContractViolationsInMessage {
+		messages = append(append([]string{}, violations[:maxContractViolationsInMessage]...),
+			fmt.Sprintf("외 %d개", len(violations)-maxContractViolationsInMessage))
+	}
+	result.Assertions = append(result.Assertions, AssertionResult{
+		Name:    name,
+		Message: fmt.Sprintf("OpenAPI %s 계약 위반: %s", result.Operation, strings.Join(messages, ", ")),
+	})
+}
+
+// 경로/쿼리/헤더 파라미터 검사 (필수 여부와 스키마, 쿠키 파라미터는 검사하지 않음)
+func (s *openAPISpec) checkParameters(operation *openAPIOperation, pathParams map[string]string, query url.Values, result *TestResult) []string {
+	var violations []string
+	for _, param := range operation.params {
+		var values []string
+		switch param.in {
+		case "path":
+			if value, ok := pathParams[param.name]; ok {
+				values = []string{value}
+			}
+		case "query":
+			values = query[param.name]
+		case "header":
+			// Accept, Content-Type, Authorization은 명세에서도 파라미터로 다루지 않는다
+			switch strings.ToLower(param.name) {
+			case "accept", "content-type", "authorization":
+				continue
+			}
+			for key, value := range result.RequestHeaders {
+				if strings.EqualFold(key, param.name) {
+					values = []string{value}
+				}
+			}
+		default:
+			continue
+		}
+
+		if len(values) == 0 {
+			if param.required || param.in == "path" {
+				violations = append(violations, fmt.Sprintf("필수 %s 파라미터 %s 없음", param.in, param.name))
+			}
+			continue
+		}
+		if param.schema == "" {
+			continue
+		}
+
+		schema, err := s.schema(param.schema)
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("%s 파라미터 %s의 스키마 오류: %v", param.in, param.name, err))
+			continue
+		}
+		node, _ := s.resolve(s.lookupPointer(param.schema), param.schema)
+		if err := schema.Validate(coerceParameter(s, node, values)); err != nil {
+			violations = append(violations, contractSchemaViolations(fmt.Sprintf("%s 파라미터 %s", param.in, param.name), err)...)
+		}
+	}
+	return violations
+}
+
+// 문자열로 전달된 파라미터 값을 스키마 타입에 맞게 변환 (변환할 수 없으면 문자열 그대로 두어 검증에서 걸리게 함)
+func coerceParameter(s *openAPISpec, schema interface{}, values []string) interface{} {
+	node, _ := schema.(map[string]interface{})
+	if schemaType(node) == "array" {
+		if len(values) == 1 {
+			values = strings.Split(values[0], ",")
+		}
+		items, _ := s.resolve(node["items"], "")
+		array := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			array = append(array, coerceParameter(s, items, []string{value}))
+		}
+		return array
+	}
+
+	value := values[0]
+	switch schemaType(node) {
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return json.Number(value)
+		}
+	case "boolean":
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	}
+	return value
+}
+
+// 스키마의 type (3.1처럼 ["integer", "null"] 목록이면 null이 아닌 첫 타입)
+func schemaType(node map[string]interface{}) string {
+	switch t := node["type"].(type) {
+	case string:
+		return t
+	case []interface{}:
+		for _, entry := range t {
+			if name, ok := entry.(string); ok && name != "null" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// 요청 본문 검사 (필수 여부와 JSON 본문의 스키마)
+func (s *openAPISpec) checkRequestBody(operation *openAPIOperation, result *TestResult) []string {
+	if operation.node["requestBody"] == nil {
+		return nil
+	}
+	resolved, pointer := s.resolve(operation.node["requestBody"], operation.pointer+"/requestBody")
+	requestBody, _ := resolved.(map[string]interface{})
+	if requestBody == nil {
+		return nil
+	}
+
+	if strings.TrimSpace(result.RequestBody) == "" {
+		if required, _ := requestBody["required"].(bool); required {
+			return []string{"필수 요청 본문 없음"}
+		}
+		return nil
+	}
+
+	var contentType string
+	for key, value := range result.RequestHeaders {
+		if strings.EqualFold(key, "Content-Type") {
+			contentType = value
+		}
+	}
+	return s.checkContent(requestBody, pointer, contentType, result.RequestBody, "요청 본문")
+}
+
+// 응답 상태 코드와 본문 검사 (코드별 → 2XX 범위 → default 순으로 응답 정의를 찾음)
+func (s *openAPISpec) checkResponse(operation *openAPIOperation, result *TestResult) []string {
+	responses, _ := operation.node["responses"].(map[string]interface{})
+	key := responseKey(responses, result.StatusCode)
+	if key == "" {
+		declared := sortedMapKeys(responses)
+		return []string{fmt.Sprintf("선언되지 않은 상태 코드 %d (명세: %s)", result.StatusCode, strings.Join(declared, ", "))}
+	}
+
+	resolved, pointer := s.resolve(responses[key], operation.pointer+"/responses/"+escapePointer(key))
+	response, _ := resolved.(map[string]interface{})
+	if response == nil || strings.TrimSpace(result.ResponseBody) == "" {
+		return nil
+	}
+	contentType := http.Header(result.ResponseHeaders).Get("Content-Type")
+	return s.checkContent(response, pointer, contentType, result.ResponseBody, "응답 본문")
+}
+
+// content의 미디어 타입을 찾아 JSON 본문을 스키마로 검증 (content가 없으면 검사하지 않음)
+func (s *openAPISpec) checkContent(node map[string]interface{}, pointer, contentType, body, label string) []string {
+	content, _ := node["content"].(map[string]interface{})
+	if len(content) == 0 {
+		return nil
+	}
+
+	actual := mediaType(contentType)
+	key := ""
+	if _, ok := content[actual]; ok {
+		key = actual
+	} else {
+		// application/*, */* 같은 범위 지정, 요청에 Content-Type이 없으면 JSON 미디어 타입을 사용
+		for _, candidate := range sortedMapKeys(content) {
+			if mediaTypeMatches(candidate, actual) || actual == "" && strings.Contains(candidate, "json") {
+				key = candidate
+				break
+			}
+		}
+	}
+	if key == "" {
+		return []string{fmt.Sprintf("%s Content-Type %q이(가) 명세에 없음 (명세: %s)", label, contentType, strings.Join(sortedMapKeys(content), ", "))}
+	}
+
+	media, _ := content[key].(map[string]interface{})
+	if media["schema"] == nil || !strings.Contains(key, "json") && !strings.Contains(actual, "json") {
+		return nil
+	}
+
+	schema, err := s.schema(pointer + "/content/" + escapePointer(key) + "/schema")
+	if err != nil {
+		return []string{fmt.Sprintf("%s 스키마 오류: %v", label, err)}
+	}
+	document, err := jsonschema.UnmarshalJSON(strings.NewReader(body))
+	if err != nil {
+		return []string{fmt.Sprintf("%s이(가) JSON이 아님", label)}
+	}
+	if err := schema.Validate(document); err != nil {
+		return contractSchemaViolations(label, err)
+	}
+	return nil
+}
+
+// "application/*", "*/*" 형태의 범위 지정과 일치하는지 여부
+func mediaTypeMatches(pattern, actual string) bool {
+	if pattern == "*/*" {
+		return true
+	}
+	if strings.HasSuffix(pattern, "/*") {
+		return strings.HasPrefix(actual, strings.TrimSuffix(pattern, "*"))
+	}
+	return false
+}
+
+// 스키마 검증 오류를 "라벨 /경로: 메시지" 목록으로 변환
+func contractSchemaViolations(label string, err error) []string {
+	validationErr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return []string{fmt.Sprintf("%s: %v", label, err)}
+	}
+	var violations []string
+	for _, schemaErr := range schemaErrorList(validationErr) {
+		if schemaErr.Path == "" {
+			violations = append(violations, label+": "+schemaErr.Message)
+			continue
+		}
+		violations = append(violations, label+" "+schemaErr.String())
+	}
+	return violations
+}
+
+// JSON 포인터 토큰 이스케이프 (~ → ~0, / → ~1)
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func sortedMapKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// YAML 또는 JSON 명세 파일 로더 (스키마 컴파일러가 외부 파일 $ref를 따라갈 때도 사용)
+// OpenAPI 3.0의 nullable: true는 JSON Schema의 type: [타입, "null"]로 바꾼다
+type openAPILoader struct{}
+
+func (openAPILoader) Load(location string) (interface{}, error) {
+	path, err := jsonschema.FileLoader{}.ToFile(location)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var document interface{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return convertNullable(jsonCompatible(document)), nil
+}
+
+// YAML 값을 JSON과 같은 형태로 변환 (200: 같은 숫자 키를 문자열로)
+func jsonCompatible(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			v[key] = jsonCompatible(child)
+		}
+		return v
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			converted[fmt.Sprint(key)] = jsonCompatible(child)
+		}
+		return converted
+	case []interface{}:
+		for i, child := range v {
+			v[i] = jsonCompatible(child)
+		}
+		return v
+	}
+	return value
+}
+
+func convertNullable(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if nullable, ok := v["nullable"].(bool); ok {
+			if t, isString := v["type"].(string); isString && nullable {
+				v["type"] = []interface{}{t, "null"}
+			}
+			delete(v, "nullable")
+		}
+		for _, child := range v {
+			convertNullable(child)
+		}
+	case []interface{}:
+		for _, child := range v {
+			convertNullable(child)
+		}
+	}
+	return value
+}
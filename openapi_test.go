@@ -0,0 +1,179 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testOpenAPISpec = `
+openapi: 3.0.3
+info: {title: test, version: "1"}
+servers:
+  - url: https://api.example.com/v1
+paths:
+  /users:
+    get:
+      parameters:
+        - {name: ids, in: query, schema: {type: array, items: {type: integer}}}
+        - {name: limit, in: query, schema: {type: integer, maximum: 100}}
+        - {name: active, in: query, schema: {type: boolean}}
+      responses:
+        "200": {description: ok}
+    post:
+      responses:
+        "201": {description: created}
+  /users/me:
+    get:
+      responses:
+        "200": {description: ok}
+  /users/{id}:
+    parameters:
+      - {name: id, in: path, required: true, schema: {$ref: "#/components/schemas/Id"}}
+    get:
+      responses:
+        "200": {description: ok}
+        4XX: {description: error}
+  /users/{id}/posts/{postId}:
+    get:
+      responses:
+        default: {description: any}
+components:
+  schemas:
+    Id: {type: integer}
+`
+
+func loadTestOpenAPISpec(t *testing.T) *openAPISpec {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "openapi.yaml")
+	if err := os.WriteFile(path, []byte(testOpenAPISpec), 0644); err != nil {
+		t.Fatal(err)
+	}
+	spec, err := loadOpenAPISpec(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return spec
+}
+
+func TestOpenAPIMatch(t *testing.T) {
+	spec := loadTestOpenAPISpec(t)
+
+	tests := []struct {
+		method, path string
+		want         string // 일치한 오퍼레이션 ("" 이면 일치 없음)
+		params       map[string]string
+		methods      []string
+	}{
+		{"GET", "/v1/users", "GET /users", map[string]string{}, nil},
+		{"GET", "/v1/users/", "GET /users", map[string]string{}, nil},
+		{"GET", "/v1/users/me", "GET /users/me", map[string]string{}, nil},
+		{"GET", "/v1/users/42", "GET /users/{id}", map[string]string{"id": "42"}, nil},
+		{"GET", "/v1/users/a%20b", "GET /users/{id}", map[string]string{"id": "a b"}, nil},
+		{"GET", "/v1/users/42/posts/7", "GET /users/{id}/posts/{postId}", map[string]string{"id": "42", "postId": "7"}, nil},
+		{"DELETE", "/v1/users", "", nil, []string{"GET", "POST"}},
+		{"GET", "/users", "GET /users", map[string]string{}, nil}, // 서버 경로 없이 호출해도 일치
+		{"GET", "/v1/orders", "", nil, nil},
+		{"GET", "/v1/users/42/posts", "", nil, nil},
+	}
+	for _, tt := range tests {
+		operation, params, methods := spec.match(tt.method, tt.path)
+		got := ""
+		if operation != nil {
+			got = operation.Method + " " + operation.Path
+		}
+		if got != tt.want {
+			t.Errorf("match(%s %s) = %q, want %q", tt.method, tt.path, got, tt.want)
+			continue
+		}
+		if operation != nil && !reflect.DeepEqual(params, tt.params) {
+			t.Errorf("match(%s %s) params = %v, want %v", tt.method, tt.path, params, tt.params)
+		}
+		if operation == nil && !reflect.DeepEqual(methods, tt.methods) {
+			t.Errorf("match(%s %s) methods = %v, want %v", tt.method, tt.path, methods, tt.methods)
+		}
+	}
+}
+
+func TestMatchPathTemplate(t *testing.T) {
+	tests := []struct {
+		template, path string
+		literals       int
+		ok             bool
+	}{
+		{"users/me", "users/me", 2, true},
+		{"users/{id}", "users/me", 1, true},
+		{"{a}/{b}", "users/me", 0, true},
+		{"users/{id}", "users/", 0, false}, // 빈 경로 변수
+		{"users/{id}", "users/1/posts", 0, false},
+		{"users/me", "users/you", 0, false},
+	}
+	for _, tt := range tests {
+		_, literals, ok := matchPathTemplate(strings.Split(tt.template, "/"), strings.Split(tt.path, "/"))
+		if ok != tt.ok || (ok && literals != tt.literals) {
+			t.Errorf("matchPathTemplate(%q, %q) = %d, %v; want %d, %v", tt.template, tt.path, literals, ok, tt.literals, tt.ok)
+		}
+	}
+}
+
+func TestCoerceParameter(t *testing.T) {
+	spec := loadTestOpenAPISpec(t)
+	ref := map[string]interface{}{"$ref": "#/components/schemas/Id"}
+	resolved, _ := spec.resolve(ref, "")
+	integerArray := map[string]interface{}{"type": "array", "items": ref}
+
+	tests := []struct {
+		name   string
+		schema interface{}
+		values []string
+		want   interface{}
+	}{
+		{"integer", map[string]interface{}{"type": "integer"}, []string{"42"}, json.Number("42")},
+		{"not a number stays string", map[string]interface{}{"type": "integer"}, []string{"abc"}, "abc"},
+		{"number", map[string]interface{}{"type": "number"}, []string{"1.5"}, json.Number("1.5")},
+		{"3.1 nullable type list", map[string]interface{}{"type": []interface{}{"null", "integer"}}, []string{"7"}, json.Number("7")},
+		{"boolean", map[string]interface{}{"type": "boolean"}, []string{"true"}, true},
+		{"bad boolean stays string", map[string]interface{}{"type": "boolean"}, []string{"yes"}, "yes"},
+		{"string", map[string]interface{}{"type": "string"}, []string{"007"}, "007"},
+		{"no schema", nil, []string{"x"}, "x"},
+		{"resolved ref", resolved, []string{"9"}, json.Number("9")},
+		{"array from comma list", integerArray, []string{"1,2,x"}, []interface{}{json.Number("1"), json.Number("2"), "x"}},
+		{"array from repeated params", integerArray, []string{"3", "4"}, []interface{}{json.Number("3"), json.Number("4")}},
+	}
+	for _, tt := range tests {
+		if got := coerceParameter(spec, tt.schema, tt.values); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: coerceParameter(%v) = %#v, want %#v", tt.name, tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestOpenAPIEvaluateParameters(t *testing.T) {
+	spec := loadTestOpenAPISpec(t)
+
+	tests := []struct {
+		url       string
+		status    int
+		violation string // 포함되어야 할 위반 ("" 이면 위반 없음)
+	}{
+		{"https://api.example.com/v1/users?ids=1,2&limit=10&active=true", 200, ""},
+		{"https://api.example.com/v1/users?ids=1,a", 200, "ids"},
+		{"https://api.example.com/v1/users?limit=500", 200, "limit"},
+		{"https://api.example.com/v1/users/abc", 200, "id"},
+		{"https://api.example.com/v1/users/42", 404, ""},
+		{"https://api.example.com/v1/users/42", 500, "500"},
+	}
+	for _, tt := range tests {
+		result := TestResult{Method: "GET", URL: tt.url, StatusCode: tt.status}
+		spec.evaluate(&result)
+		joined := strings.Join(result.ContractViolations, "; ")
+		if tt.violation == "" && joined != "" {
+			t.Errorf("%s (%d): unexpected violations: %s", tt.url, tt.status, joined)
+		}
+		if tt.violation != "" && !strings.Contains(joined, tt.violation) {
+			t.Errorf("%s (%d): violations %q, want one mentioning %q", tt.url, tt.status, joined, tt.violation)
+		}
+	}
+}
@@ -1,13 +1,17 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
 // Postman Collection 구조체 정의
 type Collection struct {
-	Info CollectionInfo `json:"info"`
-	Item []Item         `json:"item"`
+	Info     CollectionInfo `json:"info"`
+	Item     []Item         `json:"item"`
+	Variable []Variable     `json:"variable,omitempty"`
+	Auth     *Auth          `json:"auth,omitempty"` // 컬렉션 전체에 적용되는 인증
 }
 
 type CollectionInfo struct {
@@ -16,29 +20,58 @@ type CollectionInfo struct {
 }
 
 type Item struct {
-	Name    string            `json:"name"`
-	Item    []Item            `json:"item,omitempty"` // 중첩된 폴더 구조
-	Request *Request          `json:"request,omitempty"`
-	Event   []Event           `json:"event,omitempty"`
+	Name        string      `json:"name"`
+	Description interface{} `json:"description,omitempty"` // string 또는 {content, type} 객체
+	Item        []Item      `json:"item,omitempty"`        // 중첩된 폴더 구조
+	Request     *Request    `json:"request,omitempty"`
+	Auth        *Auth       `json:"auth,omitempty"` // 폴더 단위 인증 (하위 요청에 상속)
+	Event       []Event     `json:"event,omitempty"`
+	Response    []Example   `json:"response,omitempty"` // 저장된 응답 예제
+}
+
+// 요청에 저장된 응답 예제 (Postman의 "Save as example")
+type Example struct {
+	Name   string         `json:"name"`
+	Status string         `json:"status,omitempty"` // 상태 문구 (예: "Bad Request")
+	Code   int            `json:"code,omitempty"`
+	Header ExampleHeaders `json:"header,omitempty"`
+	Body   string         `json:"body,omitempty"`
+}
+
+// 예제의 응답 헤더 (스키마상 문자열이나 null도 허용되므로 배열이 아니면 비워 둠)
+type ExampleHeaders []Header
+
+func (h *ExampleHeaders) UnmarshalJSON(data []byte) error {
+	var headers []Header
+	if err := json.Unmarshal(data, &headers); err != nil {
+		*h = nil
+		return nil
+	}
+	*h = headers
+	return nil
 }
 
 type Request struct {
-	Method string      `json:"method"`
-	Header []Header    `json:"header"`
-	Body   *Body       `json:"body,omitempty"`
-	URL    interface{} `json:"url"` // string 또는 URL 객체
+	Method      string      `json:"method"`
+	Description interface{} `json:"description,omitempty"` // string 또는 {content, type} 객체
+	Header      []Header    `json:"header"`
+	Body        *Body       `json:"body,omitempty"`
+	Auth        *Auth       `json:"auth,omitempty"`
+	URL         interface{} `json:"url"` // string 또는 URL 객체
 }
 
 type Header struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
-	Type  string `json:"type"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Type     string `json:"type"`
+	Disabled bool   `json:"disabled,omitempty"`
 }
 
 type Body struct {
-	Mode    string      `json:"mode"`
-	Raw     string      `json:"raw,omitempty"`
-	Options *BodyOptions `json:"options,omitempty"`
+	Mode       string       `json:"mode"`
+	Raw        string       `json:"raw,omitempty"`
+	URLEncoded []Query      `json:"urlencoded,omitempty"`
+	Options    *BodyOptions `json:"options,omitempty"`
 }
 
 type BodyOptions struct {
@@ -59,13 +92,56 @@ type URL struct {
 }
 
 type Query struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Disabled bool   `json:"disabled,omitempty"`
+}
+
+// 컬렉션 변수 ({{key}} 형태로 참조)
+type Variable struct {
+	Key      string      `json:"key"`
+	Value    interface{} `json:"value"` // 문자열 외에 숫자/불리언으로 저장되기도 함
+	Type     string      `json:"type,omitempty"`
+	Disabled bool        `json:"disabled,omitempty"`
+}
+
+// 인증 설정 (type에 따라 해당 필드의 key/value 목록 사용)
+type Auth struct {
+	Type   string     `json:"type"` // bearer, basic, apikey, noauth
+	Bearer AuthParams `json:"bearer,omitempty"`
+	Basic  AuthParams `json:"basic,omitempty"`
+	APIKey AuthParams `json:"apikey,omitempty"`
+}
+
+// 인증 파라미터 목록 (v2.1은 key/value 배열, v2.0은 {"token": "abc"} 형태의 객체)
+type AuthParams []AuthParam
+
+func (p *AuthParams) UnmarshalJSON(data []byte) error {
+	var list []AuthParam
+	if err := json.Unmarshal(data, &list); err == nil {
+		*p = list
+		return nil
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return fmt.Errorf("인증 파라미터는 배열 또는 객체여야 합니다")
+	}
+	*p = nil
+	for _, key := range sortedMapKeys(object) {
+		*p = append(*p, AuthParam{Key: key, Value: object[key]})
+	}
+	return nil
+}
+
+type AuthParam struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+	Type  string      `json:"type,omitempty"`
 }
 
 type Event struct {
-	Listen string       `json:"listen"`
-	Script EventScript  `json:"script"`
+	Listen string      `json:"listen"`
+	Script EventScript `json:"script"`
 }
 
 type EventScript struct {
@@ -75,26 +151,61 @@ type EventScript struct {
 
 // 테스트 결과 구조체
 type TestResult struct {
-	Name           string        `json:"name"`
-	Method         string        `json:"method"`
-	URL            string        `json:"url"`
-	StatusCode     int           `json:"status_code"`
-	ResponseTime   time.Duration `json:"response_time"`
-	Success        bool          `json:"success"`
-	ErrorMessage   string        `json:"error_message,omitempty"`
-	ResponseBody   string        `json:"response_body,omitempty"`
-	RequestHeaders map[string]string `json:"request_headers"`
-	Timestamp      time.Time     `json:"timestamp"`
+	Name               string              `json:"name"`
+	Folder             string              `json:"folder,omitempty"` // 상위 폴더 경로 (예: "Users/Admin")
+	Method             string              `json:"method"`
+	URL                string              `json:"url"`
+	StatusCode         int                 `json:"status_code"`
+	ResponseTime       time.Duration       `json:"response_time"`     // 전송 시작부터 응답 본문 수신 완료까지
+	Timings            *RequestTimings     `json:"timings,omitempty"` // 단계별 소요 시간 (요청을 전송한 경우)
+	Success            bool                `json:"success"`
+	Skipped            bool                `json:"skipped,omitempty"`
+	SkipReason         string              `json:"skip_reason,omitempty"`
+	ErrorMessage       string              `json:"error_message,omitempty"`
+	ResponseBody       string              `json:"response_body,omitempty"`
+	BodySize           int                 `json:"body_size,omitempty"`      // 응답 본문 전체 크기 (바이트)
+	BodyTruncated      bool                `json:"body_truncated,omitempty"` // -max-body-capture로 본문 일부만 기록
+	BodyOmitted        bool                `json:"body_omitted,omitempty"`   // -no-bodies로 본문을 기록하지 않음
+	BodyBinary         bool                `json:"body_binary,omitempty"`    // 바이너리 본문 (텍스트로 기록하지 않음)
+	BodySHA256         string              `json:"body_sha256,omitempty"`    // 바이너리 본문의 SHA-256
+	BodyEncoding       string              `json:"body_encoding,omitempty"`  // 기록한 본문의 인코딩 (base64)
+	ResponseHeaders    map[string][]string `json:"response_headers,omitempty"`
+	HTTPVersion        string              `json:"http_version,omitempty"` // 응답 프로토콜 (예: HTTP/1.1)
+	RequestHeaders     map[string]string   `json:"request_headers"`
+	RequestBody        string              `json:"request_body,omitempty"`
+	Unresolved         []string            `json:"unresolved_variables,omitempty"` // 값을 찾지 못한 {{변수}}
+	Curl               string              `json:"curl,omitempty"`                 // 요청을 재현하는 curl 명령 (-curl 사용 시)
+	Assertions         []AssertionResult   `json:"assertions,omitempty"`
+	SchemaErrors       []SchemaError       `json:"schema_errors,omitempty"`       // JSON Schema 검증 오류 (경로와 메시지)
+	SnapshotDiff       []string            `json:"snapshot_diff,omitempty"`       // 저장된 스냅샷과 다른 부분 (경로별)
+	ExampleViolations  []string            `json:"example_violations,omitempty"`  // 저장 예제와 다른 부분 (-verify-examples)
+	Operation          string              `json:"operation,omitempty"`           // 일치한 OpenAPI 오퍼레이션 (예: GET /users/{id})
+	ContractViolations []string            `json:"contract_violations,omitempty"` // OpenAPI 명세 위반 사항 (-openapi)
+	SLAExceeded        bool                `json:"sla_exceeded,omitempty"`        // 응답 시간 SLA 초과 (-max-response-time, -sla)
+	Timestamp          time.Time           `json:"timestamp"`
+}
+
+// 요청 하나에 대한 개별 검증 결과 (상태 코드 확인 등)
+type AssertionResult struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message,omitempty"`
+	SLA     bool   `json:"sla,omitempty"` // 기능이 아닌 응답 시간 SLA 검증
 }
 
 type TestSummary struct {
-	CollectionName string        `json:"collection_name"`
-	FilePath       string        `json:"file_path"`
-	TotalTests     int           `json:"total_tests"`
-	PassedTests    int           `json:"passed_tests"`
-	FailedTests    int           `json:"failed_tests"`
-	TotalTime      time.Duration `json:"total_time"`
-	Results        []TestResult  `json:"results"`
-	StartTime      time.Time     `json:"start_time"`
-	EndTime        time.Time     `json:"end_time"`
-}
\ No newline at end of file
+	CollectionName string             `json:"collection_name"`
+	FilePath       string             `json:"file_path"`
+	TotalTests     int                `json:"total_tests"`
+	PassedTests    int                `json:"passed_tests"`
+	FailedTests    int                `json:"failed_tests"`
+	SkippedTests   int                `json:"skipped_tests,omitempty"`
+	TotalTime      time.Duration      `json:"total_time"`
+	DryRun         bool               `json:"dry_run,omitempty"` // 요청을 전송하지 않고 해석 결과만 기록한 실행
+	Results        []TestResult       `json:"results"`
+	Latency        *CollectionLatency `json:"latency,omitempty"`        // 응답 시간 통계 (응답을 받은 요청이 없으면 nil)
+	Coverage       *APICoverage       `json:"api_coverage,omitempty"`   // OpenAPI 명세 대비 커버리지 (-openapi 사용 시)
+	SLAViolations  []string           `json:"sla_violations,omitempty"` // 응답 시간 통계 예산 위반 (-sla의 budgets)
+	StartTime      time.Time          `json:"start_time"`
+	EndTime        time.Time          `json:"end_time"`
+}
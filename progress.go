@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// 병렬 실행 중 컬렉션별 진행 상황을 표시하는 화면
+// TTY에서는 여러 줄을 다시 그리는 라이브 모드로, CI 로그처럼 TTY가 아니면 일반 출력으로 동작
+type progressDisplay struct {
+	mu       sync.Mutex
+	out      io.Writer
+	live     bool
+	lines    []progressLine
+	finished int
+	drawn    int            // 현재 화면에 그려진 진행 표시 줄 수
+	pending  map[int]string // 앞선 컬렉션이 끝나기를 기다리는 출력 (입력 순서대로 기록)
+	next     int            // 다음에 기록할 컬렉션 인덱스
+}
+
+type progressLine struct {
+	name    string
+	done    int
+	total   int
+	running bool
+}
+
+// out이 터미널 파일이 아니면(버퍼, 파이프, 파일) 일반 출력으로 동작
+func newProgressDisplay(out io.Writer, files []string) *progressDisplay {
+	f, isFile := out.(*os.File)
+	p := &progressDisplay{
+		out:     out,
+		live:    isFile && isTerminal(f),
+		lines:   make([]progressLine, len(files)),
+		pending: make(map[int]string),
+	}
+	for i, file := range files {
+		p.lines[i].name = filepath.Base(file)
+	}
+	return p
+}
+
+// 출력 대상이 터미널인지 확인 (TERM=dumb이면 라이브 모드 사용 안 함)
+func isTerminal(f *os.File) bool {
+	if os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// 컬렉션 실행 시작
+func (p *progressDisplay) Start(index, total int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lines[index].running = true
+	p.lines[index].total = total
+	if !p.live {
+		fmt.Fprintf(p.out, "%s▶ 시작: %s (%d개 요청)\n", p.prefix(index), p.lines[index].name, total)
+		return
+	}
+	p.redraw()
+}
+
+// 요청 하나가 끝날 때마다 진행률 갱신 (라이브 모드에서만 표시)
+func (p *progressDisplay) Advance(index int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lines[index].done++
+	if p.live {
+		p.redraw()
+	}
+}
+
+// 컬렉션 실행 종료와 함께 버퍼링된 출력을 기록
+// 출력은 최종 리포트와 같은 입력 순서로 기록하므로 앞선 컬렉션이 끝날 때까지 보관한다
+func (p *progressDisplay) Finish(index int, output string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lines[index].running = false
+	p.finished++
+	p.pending[index] = output
+
+	p.clear()
+	for {
+		output, ok := p.pending[p.next]
+		if !ok {
+			break
+		}
+		delete(p.pending, p.next)
+		for _, line := range strings.SplitAfter(output, "\n") {
+			if line != "" {
+				fmt.Fprint(p.out, p.prefix(p.next)+line)
+			}
+		}
+		p.next++
+	}
+	if p.live {
+		p.redraw()
+	}
+}
+
+// 라이브 진행 표시 제거 (모든 작업 완료 후 호출)
+func (p *progressDisplay) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.clear()
+}
+
+// 입력 순서 표시 (예: "[2/5] ", CI 로그와 최종 리포트를 맞춰 볼 수 있도록)
+func (p *progressDisplay) prefix(index int) string {
+	return fmt.Sprintf("[%d/%d] ", index+1, len(p.lines))
+}
+
+func (p *progressDisplay) clear() {
+	if p.drawn == 0 {
+		return
+	}
+	// 커서를 진행 표시 첫 줄로 옮긴 뒤 그 아래를 모두 지움
+	fmt.Fprintf(p.out, "\033[%dA\033[J", p.drawn)
+	p.drawn = 0
+}
+
+func (p *progressDisplay) redraw() {
+	p.clear()
+
+	var sb strings.Builder
+	count := 0
+	for _, line := range p.lines {
+		if !line.running {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("  ⏳ %s %s %d/%d\n", line.name, progressBar(line.done, line.total, 20), line.done, line.total))
+		count++
+	}
+	sb.WriteString(fmt.Sprintf("  📦 컬렉션 %d/%d 완료\n", p.finished, len(p.lines)))
+	count++
+
+	fmt.Fprint(p.out, sb.String())
+	p.drawn = count
+}
+
+func progressBar(done, total, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = done * width / total
+	}
+	if filled > width {
+		filled = width
+	}
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", width-filled) + "]"
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 요청 경로가 /slow이면 늦게 응답하는 서버에 보내는 컬렉션 파일 생성
+func writeProgressCollection(t *testing.T, dir, name, baseURL string, paths ...string) string {
+	t.Helper()
+	var items []string
+	for i, path := range paths {
+		items = append(items, fmt.Sprintf(`{"name": "R%d", "request": {"method": "GET", "url": "%s%s"}}`, i+1, baseURL, path))
+	}
+	content := fmt.Sprintf(`{"info": {"name": %q, "schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"}, "item": [%s]}`,
+		name, strings.Join(items, ","))
+	file := filepath.Join(dir, name+".json")
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestRunCollectionsInParallelOrderedOutput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(300 * time.Millisecond)
+		}
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	// 첫 번째 컬렉션이 가장 늦게 끝나도 출력과 결과는 입력 순서를 따라야 한다
+	dir := t.TempDir()
+	files := []string{
+		writeProgressCollection(t, dir, "first", server.URL, "/slow", "/ok"),
+		writeProgressCollection(t, dir, "second", server.URL, "/ok"),
+		filepath.Join(dir, "broken.json"),
+		writeProgressCollection(t, dir, "fourth", server.URL, "/ok", "/missing"),
+	}
+	if err := os.WriteFile(files[2], []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	summaries := runCollectionsInParallel(files, len(files), false, &out, NewRunner)
+
+	var names []string
+	for _, summary := range summaries {
+		names = append(names, summary.CollectionName)
+	}
+	if got := strings.Join(names, ","); got != "first,second,fourth" {
+		t.Errorf("summaries = %s, want input order without the broken file", got)
+	}
+
+	text := out.String()
+	if strings.Contains(text, "\033[") {
+		t.Errorf("non-terminal output contains escape sequences:\n%s", text)
+	}
+
+	// 시작 줄은 실행 순서대로 섞여 나오지만 모두 입력 순서 번호가 붙는다
+	var finished []string
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for _, line := range lines[:len(lines)-1] {
+		if !strings.HasPrefix(line, "[") {
+			t.Errorf("line without [i/n] prefix: %q", line)
+			continue
+		}
+		if !strings.Contains(line, "▶ 시작") {
+			finished = append(finished, line)
+		}
+	}
+	want := []string{
+		"[1/4] ✅ first.json: 2/2 성공",
+		"[2/4] ✅ second.json: 1/1 성공",
+		"[3/4] ❌ 컬렉션 로드 실패: " + files[2],
+		"[4/4] ❌ fourth.json: 1/2 성공",
+	}
+	if len(finished) != len(want) {
+		t.Fatalf("finished lines = %q, want %d lines", finished, len(want))
+	}
+	for i := range want {
+		if !strings.HasPrefix(finished[i], want[i]) {
+			t.Errorf("finished line %d = %q, want prefix %q", i, finished[i], want[i])
+		}
+	}
+	for _, prefix := range []string{"[1/4] ▶ 시작: first.json (2개 요청)", "[2/4] ▶ 시작: second.json (1개 요청)", "[4/4] ▶ 시작: fourth.json (2개 요청)"} {
+		start := strings.Index(text, prefix)
+		if start < 0 || start > strings.Index(text, want[0]) {
+			t.Errorf("start line %q missing or printed after the first collection finished", prefix)
+		}
+	}
+	if last := lines[len(lines)-1]; last != "✅ 3개 컬렉션 병렬 실행 완료" {
+		t.Errorf("last line = %q", last)
+	}
+}
+
+func TestProgressDisplayBuffersUntilEarlierCollectionsFinish(t *testing.T) {
+	var out bytes.Buffer
+	display := newProgressDisplay(&out, []string{"a.json", "b.json", "c.json"})
+	if display.live {
+		t.Fatal("buffer output must not use live mode")
+	}
+
+	display.Finish(2, "c done\n")
+	display.Finish(1, "b line 1\nb line 2\n")
+	if out.Len() != 0 {
+		t.Fatalf("output before the first collection finished: %q", out.String())
+	}
+	display.Finish(0, "a done\n")
+
+	want := "[1/3] a done\n[2/3] b line 1\n[2/3] b line 2\n[3/3] c done\n"
+	if got := out.String(); got != want {
+		t.Errorf("output:\n got  %q\n want %q", got, want)
+	}
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// 오류 메시지 안의 URL (net/http 오류는 요청 URL을 그대로 포함)
+var urlPattern = regexp.MustCompile(`https?://[^\s"']+`)
+
+// 리포트에 기록하기 전에 결과의 민감 정보를 가림 (nil이면 가리지 않음)
+// 자동 마스킹: 인증 헤더 등 민감한 헤더와 쿼리/폼 파라미터, secret 타입 변수 값
+// -redact 규칙: /정규식/ 은 모든 텍스트에서, $로 시작하는 JSONPath는 JSON 본문에서, 그 외는 헤더/파라미터 이름
+type redactor struct {
+	auto     bool            // 자동 마스킹 사용 (-reveal-secrets이면 false)
+	names    map[string]bool // 추가로 가릴 헤더/파라미터 이름 (소문자)
+	patterns []*regexp.Regexp
+	paths    []*jsonPath
+}
+
+// -redact 규칙 목록(쉼표 구분)으로 생성 (-reveal-secrets이고 규칙이 없으면 nil)
+func newRedactor(rules string, reveal bool) (*redactor, error) {
+	r := &redactor{auto: !reveal, names: make(map[string]bool)}
+	for _, rule := range strings.Split(rules, ",") {
+		if rule = strings.TrimSpace(rule); rule == "" {
+			continue
+		}
+		if pattern, ok := slashPattern(rule); ok {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("잘못된 -redact 정규식 %s: %v", rule, err)
+			}
+			r.patterns = append(r.patterns, re)
+			continue
+		}
+		if strings.HasPrefix(rule, "$") {
+			path, err := parseJSONPath(rule)
+			if err != nil {
+				return nil, fmt.Errorf("잘못된 -redact JSONPath: %v", err)
+			}
+			r.paths = append(r.paths, path)
+			continue
+		}
+		r.names[strings.ToLower(rule)] = true
+	}
+
+	if !r.auto && len(r.names) == 0 && len(r.patterns) == 0 && len(r.paths) == 0 {
+		return nil, nil
+	}
+	return r, nil
+}
+
+// 응답 본문 마스킹 (본문 크기 제한을 적용하기 전에 전체 본문에 적용, 바이너리 본문은 그대로)
+func (r *redactor) body(contentType string, body []byte, secrets []string) []byte {
+	if r == nil || len(body) == 0 || isBinaryBody(contentType, body) {
+		return body
+	}
+	return []byte(r.text(r.document(string(body)), secrets))
+}
+
+// 결과에 기록된 요청 정보와 검증 메시지 마스킹
+// 응답 본문은 body에서, curl 명령은 renderCurl에서 같은 규칙으로 처리한다
+func (r *redactor) apply(result *TestResult, secrets []string) {
+	if r == nil {
+		return
+	}
+
+	result.URL = r.url(result.URL, secrets)
+	result.ErrorMessage = r.text(urlPattern.ReplaceAllStringFunc(result.ErrorMessage, r.query), secrets)
+
+	for key, value := range result.RequestHeaders {
+		result.RequestHeaders[key] = r.headerValue(key, value, secrets)
+	}
+	for key, values := range result.ResponseHeaders {
+		masked := make([]string, len(values))
+		for i, value := range values {
+			masked[i] = r.headerValue(key, value, secrets)
+		}
+		result.ResponseHeaders[key] = masked
+	}
+	result.RequestBody = r.requestBody(result.RequestBody, result.RequestHeaders["Content-Type"], secrets)
+
+	for i := range result.Assertions {
+		result.Assertions[i].Name = r.text(result.Assertions[i].Name, secrets)
+		result.Assertions[i].Message = r.text(result.Assertions[i].Message, secrets)
+	}
+	for i := range result.SchemaErrors {
+		result.SchemaErrors[i].Message = r.text(result.SchemaErrors[i].Message, secrets)
+	}
+	for _, list := range [][]string{result.SnapshotDiff, result.ExampleViolations, result.ContractViolations} {
+		for i := range list {
+			list[i] = r.text(list[i], secrets)
+		}
+	}
+}
+
+// URL의 민감한 쿼리 파라미터와 secret 값을 가림 (nil이면 그대로)
+func (r *redactor) url(rawURL string, secrets []string) string {
+	if r == nil {
+		return rawURL
+	}
+	return r.text(r.query(rawURL), secrets)
+}
+
+// 헤더 값 마스킹 (민감한 헤더 이름이면 값 전체, 그 외에는 secret 값과 정규식 일치 부분, nil이면 그대로)
+func (r *redactor) headerValue(name, value string, secrets []string) string {
+	if r == nil {
+		return value
+	}
+	return r.text(r.header(name, value), secrets)
+}
+
+// 요청 본문 마스킹 (폼은 민감한 필드, JSON은 -redact JSONPath, nil이면 그대로)
+func (r *redactor) requestBody(body, contentType string, secrets []string) string {
+	if r == nil || body == "" {
+		return body
+	}
+	if strings.HasPrefix(mediaType(contentType), "application/x-www-form-urlencoded") {
+		body = r.form(body)
+	} else {
+		body = r.document(body)
+	}
+	return r.text(body, secrets)
+}
+
+// secret 변수 값과 -redact 정규식에 일치하는 부분을 가림
+// 정규식에 괄호 그룹이 있으면 첫 번째 그룹만 가린다 (예: /"token":"([^"]+)"/)
+func (r *redactor) text(text string, secrets []string) string {
+	if text == "" {
+		return text
+	}
+	if r.auto {
+		text = maskSecrets(text, secrets)
+	}
+	for _, re := range r.patterns {
+		if re.NumSubexp() == 0 {
+			text = re.ReplaceAllLiteralString(text, maskedValue)
+			continue
+		}
+		var buf strings.Builder
+		last := 0
+		for _, match := range re.FindAllStringSubmatchIndex(text, -1) {
+			if match[2] < 0 {
+				continue
+			}
+			buf.WriteString(text[last:match[2]])
+			buf.WriteString(maskedValue)
+			last = match[3]
+		}
+		buf.WriteString(text[last:])
+		text = buf.String()
+	}
+	return text
+}
+
+// 민감한 헤더의 값을 가림
+func (r *redactor) header(name, value string) string {
+	if r.sensitive(name) {
+		return maskHeaderValue(name, value)
+	}
+	return value
+}
+
+// 자동 마스킹 대상이거나 -redact로 지정한 이름인지 (api_key처럼 _를 쓴 이름도 인식)
+func (r *redactor) sensitive(name string) bool {
+	if r.names[strings.ToLower(name)] {
+		return true
+	}
+	return r.auto && isSensitiveHeader(strings.ReplaceAll(name, "_", "-"))
+}
+
+// URL 쿼리에서 민감한 파라미터 값을 가림 (다른 부분의 인코딩과 순서는 그대로)
+func (r *redactor) query(rawURL string) string {
+	base, query, ok := strings.Cut(rawURL, "?")
+	if !ok {
+		return rawURL
+	}
+	query, fragment, hasFragment := strings.Cut(query, "#")
+	masked := base + "?" + r.form(query)
+	if hasFragment {
+		masked += "#" + fragment
+	}
+	return masked
+}
+
+// key=value&... 형식에서 민감한 이름의 값을 가림
+func (r *redactor) form(text string) string {
+	pairs := strings.Split(text, "&")
+	for i, pair := range pairs {
+		rawKey, _, ok := strings.Cut(pair, "=")
+		key := rawKey
+		if name, err := url.QueryUnescape(key); err == nil {
+			key = name
+		}
+		if ok && r.sensitive(key) {
+			pairs[i] = rawKey + "=" + maskedValue
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// JSON 본문에서 -redact JSONPath와 일치하는 값을 가림 (JSON이 아니거나 규칙이 없으면 그대로)
+// 가린 값이 있으면 한 줄 JSON으로 다시 인코딩하므로 정규식보다 먼저 적용한다
+func (r *redactor) document(body string) string {
+	if len(r.paths) == 0 {
+		return body
+	}
+	document, ok := decodeSnapshotJSON(body)
+	if !ok {
+		return body
+	}
+	original := encodeRedacted(document)
+	for _, path := range r.paths {
+		document = path.replaceAll(document, maskedValue)
+	}
+
+	// 일치한 값이 없으면 원래 형식을 유지
+	masked := encodeRedacted(document)
+	if masked == "" || masked == original {
+		return body
+	}
+	return masked
+}
+
+// 한 줄 JSON으로 인코딩 (실패하면 빈 문자열)
+func encodeRedacted(document interface{}) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(document); err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
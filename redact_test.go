@@ -0,0 +1,204 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func mustRedactor(t *testing.T, rules string, reveal bool) *redactor {
+	t.Helper()
+	r, err := newRedactor(rules, reveal)
+	if err != nil {
+		t.Fatalf("newRedactor(%q): %v", rules, err)
+	}
+	return r
+}
+
+func TestNewRedactor(t *testing.T) {
+	if r := mustRedactor(t, "", true); r != nil {
+		t.Errorf("newRedactor with -reveal-secrets and no rules = %+v, want nil", r)
+	}
+	if r := mustRedactor(t, " , ", true); r != nil {
+		t.Errorf("newRedactor with blank rules = %+v, want nil", r)
+	}
+	if r := mustRedactor(t, "X-Trace", true); r == nil || r.auto {
+		t.Errorf("newRedactor with -reveal-secrets and rules = %+v, want rules only", r)
+	}
+
+	for _, rules := range []string{"/(/", "$.items[?(@.id)]"} {
+		if _, err := newRedactor(rules, false); err == nil {
+			t.Errorf("newRedactor(%q): expected error", rules)
+		}
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		rules       string
+		contentType string
+		body        string
+		want        string
+	}{
+		{"nested JSONPath", "$..token", "application/json",
+			`{"token": "t1", "user": {"token": "t2", "sessions": [{"token": "t3"}, {"id": 1}]}}`,
+			`{"token":"****","user":{"sessions":[{"token":"****"},{"id":1}],"token":"****"}}`},
+		{"array wildcard", "$.users[*].email", "application/json",
+			`{"users": [{"email": "a@x", "id": 1}, {"email": "b@x", "id": 2}]}`,
+			`{"users":[{"email":"****","id":1},{"email":"****","id":2}]}`},
+		{"no match keeps formatting", "$..token", "application/json",
+			"{\n  \"id\": 1\n}", "{\n  \"id\": 1\n}"},
+		{"JSONPath skips non-JSON", "$..token", "text/plain", `token=abc`, `token=abc`},
+		{"regex", `/\d{3}-\d{4}/`, "text/plain", "call 555-1234 now", "call **** now"},
+		{"regex group", `/"session":"([^"]+)"/`, "application/json",
+			`{"session":"s-1","other":"s-1"}`, `{"session":"****","other":"s-1"}`},
+		{"JSONPath then regex", `$.a,/b+/`, "application/json",
+			`{"a": 1, "b": "bbb"}`, `{"a":"****","****":"****"}`},
+		{"secret value", "", "text/plain", "hello tok-ABC123", "hello ****"},
+		{"binary untouched", "/x/", "image/png", "\x89PNGx", "\x89PNGx"},
+	}
+	for _, tt := range tests {
+		r := mustRedactor(t, tt.rules, false)
+		if got := string(r.body(tt.contentType, []byte(tt.body), []string{"tok-ABC123"})); got != tt.want {
+			t.Errorf("%s:\n got  %s\n want %s", tt.name, got, tt.want)
+		}
+	}
+
+	var nilRedactor *redactor
+	if got := string(nilRedactor.body("text/plain", []byte("tok-ABC123"), []string{"tok-ABC123"})); got != "tok-ABC123" {
+		t.Errorf("nil redactor body = %q, want unchanged", got)
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	r := mustRedactor(t, "session", false)
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://x/users", "https://x/users"},
+		{"https://x/users?page=2&api_key=k1", "https://x/users?page=2&api_key=****"},
+		{"https://x/cb?access_token=a%2Fb&b=%20#frag", "https://x/cb?access_token=****&b=%20#frag"},
+		{"https://x/?api%5Fkey=k1", "https://x/?api%5Fkey=****"},
+		{"https://x/?session=s1&flag", "https://x/?session=****&flag"},
+		{"https://x/tok-ABC123/items", "https://x/****/items"},
+	}
+	for _, tt := range tests {
+		if got := r.url(tt.url, []string{"tok-ABC123"}); got != tt.want {
+			t.Errorf("url(%q) = %s, want %s", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestRedactHeaderValue(t *testing.T) {
+	r := mustRedactor(t, "X-Trace", false)
+	revealed := mustRedactor(t, "X-Trace", true)
+
+	tests := []struct {
+		r     *redactor
+		name  string
+		value string
+		want  string
+	}{
+		{r, "Authorization", "Bearer abc", "Bearer ****"},
+		{r, "Authorization", "abc", "****"},
+		{r, "X-Api-Key", "k1", "****"},
+		{r, "x-trace", "t1", "****"},
+		{r, "Accept", "application/json", "application/json"},
+		{r, "X-Echo", "tok-ABC123", "****"},
+		{revealed, "Authorization", "Bearer abc", "Bearer abc"},
+		{revealed, "X-Trace", "t1", "****"},
+		{nil, "Authorization", "Bearer abc", "Bearer abc"},
+	}
+	for _, tt := range tests {
+		if got := tt.r.headerValue(tt.name, tt.value, []string{"tok-ABC123"}); got != tt.want {
+			t.Errorf("headerValue(%s: %s) = %s, want %s", tt.name, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestRedactRequestBody(t *testing.T) {
+	r := mustRedactor(t, "$.card.number", false)
+
+	tests := []struct {
+		contentType string
+		body        string
+		want        string
+	}{
+		{"application/x-www-form-urlencoded", "user=kim&password=pw1&client_secret=c1",
+			"user=kim&password=****&client_secret=****"},
+		{"application/json", `{"card": {"number": "4111", "cvc": "123"}}`, `{"card":{"cvc":"123","number":"****"}}`},
+		{"application/json", `{"password": "tok-ABC123"}`, `{"password": "****"}`},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		if got := r.requestBody(tt.body, tt.contentType, []string{"tok-ABC123"}); got != tt.want {
+			t.Errorf("requestBody(%s, %q) = %s, want %s", tt.contentType, tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestRedactApply(t *testing.T) {
+	r := mustRedactor(t, `/pin=\d+/`, false)
+	result := TestResult{
+		URL:            "https://x/users?api_key=k1",
+		ErrorMessage:   `Get "https://x/users?token=t1&page=2": dial tcp: connection refused`,
+		RequestHeaders: map[string]string{"Authorization": "Bearer tok-ABC123", "Content-Type": "application/x-www-form-urlencoded"},
+		ResponseHeaders: map[string][]string{
+			"Set-Cookie": {"sid=1", "sid=2"},
+			"X-Echo":     {"tok-ABC123"},
+		},
+		RequestBody:        "pin=1234&access_token=a1",
+		Assertions:         []AssertionResult{{Name: "token is tok-ABC123", Message: "pin=0000"}},
+		ContractViolations: []string{"got tok-ABC123"},
+	}
+	r.apply(&result, []string{"tok-ABC123"})
+
+	want := TestResult{
+		URL:            "https://x/users?api_key=****",
+		ErrorMessage:   `Get "https://x/users?token=****&page=2": dial tcp: connection refused`,
+		RequestHeaders: map[string]string{"Authorization": "Bearer ****", "Content-Type": "application/x-www-form-urlencoded"},
+		ResponseHeaders: map[string][]string{
+			"Set-Cookie": {"****", "****"},
+			"X-Echo":     {"****"},
+		},
+		RequestBody:        "****&access_token=****",
+		Assertions:         []AssertionResult{{Name: "token is ****", Message: "****"}},
+		ContractViolations: []string{"got ****"},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("apply:\n got  %+v\n want %+v", result, want)
+	}
+}
+
+func TestSecretValuesAndMasking(t *testing.T) {
+	secrets := secretValues([]Variable{
+		{Key: "id", Value: "1", Type: "secret"},
+		{Key: "flag", Value: "true", Type: "secret"},
+		{Key: "user", Value: "admin", Type: "secret"},
+		{Key: "token", Value: "tok-ABC123", Type: "secret"},
+		{Key: "pin", Value: "123456", Type: "secret"},
+		{Key: "baseUrl", Value: "https://api.example.com", Type: "default"},
+	})
+	if want := []string{"tok-ABC123", "123456"}; !reflect.DeepEqual(secrets, want) {
+		t.Fatalf("secretValues = %q, want %q", secrets, want)
+	}
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{`HTTP 201 {"id": 1, "active": true, "user": "admin"}`, `HTTP 201 {"id": 1, "active": true, "user": "admin"}`},
+		{"Authorization: Bearer tok-ABC123", "Authorization: Bearer ****"},
+		{"https://x/users?token=tok-ABC123&page=1", "https://x/users?token=****&page=1"},
+		{`{"pin":"123456","order":"A1234567","ts":1712345678}`, `{"pin":"****","order":"A1234567","ts":1712345678}`},
+		{"123456 123456x x_123456", "**** 123456x x_****"},
+		{"tok-ABC123tok-ABC123", "tok-ABC123tok-ABC123"},
+	}
+	for _, tt := range tests {
+		if got := maskSecrets(tt.text, secrets); got != tt.want {
+			t.Errorf("maskSecrets(%q) = %s, want %s", tt.text, got, tt.want)
+		}
+	}
+}
@@ -3,49 +3,83 @@ package main
 import (
 	"encoding/json"
 	"fmt"
-	"html/template"
+	"io"
 	"os"
+	"sort"
 	"strings"
-	"time"
+	"text/template"
 )
 
+// 리포트 형식 구현체가 따라야 하는 공통 인터페이스
+type ReportGenerator interface {
+	// 실행 결과 전체를 하나의 문서로 생성
+	Generate(summaries []*TestSummary) (string, error)
+	// 파일로 저장할 때 사용할 기본 확장자
+	Extension() string
+}
+
+// 실행이 끝난 뒤가 아니라 실행 중에 이벤트를 바로 기록하는 리포트 형식이 추가로 구현하는 인터페이스
+// 이런 형식은 Listen으로 받은 리스너가 출력을 담당하므로 실행 후 Generate 결과를 따로 출력하지 않는다
+type LiveReportGenerator interface {
+	ReportGenerator
+	Listen(w io.Writer) RunListener
+}
+
+// 사용자 템플릿으로 출력 모양을 바꿀 수 있는 리포트 형식이 추가로 구현하는 인터페이스
+// 템플릿은 ReportData를 받아 실행되며 파싱 오류는 실행 전에 반환한다
+type TemplateReportGenerator interface {
+	ReportGenerator
+	WithTemplate(text string) (ReportGenerator, error)
+}
+
+// 형식 이름별 리포트 구현체 (각 형식 파일의 init에서 등록)
+var reportFormats = map[string]func() ReportGenerator{}
+
+func registerReportFormat(name string, factory func() ReportGenerator) {
+	reportFormats[name] = factory
+}
+
+// 등록된 리포트 형식 이름 목록 (정렬됨)
+func reportFormatNames() []string {
+	names := make([]string, 0, len(reportFormats))
+	for name := range reportFormats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func init() {
+	registerReportFormat("text", func() ReportGenerator { return textReport{} })
+	registerReportFormat("json", func() ReportGenerator { return jsonReport{} })
+}
 
 type Reporter struct {
-	format string
+	format    string
+	generator ReportGenerator
 }
 
+// 지정한 형식의 리포터 생성 (등록되지 않은 형식이면 text 사용)
 func NewReporter(format string) *Reporter {
-	return &Reporter{format: format}
+	factory, ok := reportFormats[format]
+	if !ok {
+		format = "text"
+		factory = reportFormats[format]
+	}
+	return &Reporter{format: format, generator: factory()}
 }
 
 func (r *Reporter) Print(summaries []*TestSummary) {
-	switch r.format {
-	case "json":
-		r.printJSON(summaries)
-	case "html":
-		r.printHTML(summaries)
-	case "csv":
-		r.printCSV(summaries)
-	default:
-		r.printText(summaries)
+	content, err := r.generator.Generate(summaries)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %s 리포트 생성 실패: %v\n", r.format, err)
+		return
 	}
+	fmt.Print(content)
 }
 
 func (r *Reporter) SaveToFile(summaries []*TestSummary, filename string) error {
-	var content string
-	var err error
-
-	switch r.format {
-	case "json":
-		content, err = r.generateJSON(summaries)
-	case "html":
-		content, err = r.generateHTML(summaries)
-	case "csv":
-		content, err = r.generateCSV(summaries)
-	default:
-		content, err = r.generateText(summaries)
-	}
-
+	content, err := r.generator.Generate(summaries)
 	if err != nil {
 		return err
 	}
@@ -53,224 +87,174 @@ func (r *Reporter) SaveToFile(summaries []*TestSummary, filename string) error {
 	return os.WriteFile(filename, []byte(content), 0644)
 }
 
-func (r *Reporter) printText(summaries []*TestSummary) {
-	content, _ := r.generateText(summaries)
-	fmt.Print(content)
+// 실행 중에 바로 기록하는 형식인지 여부
+func (r *Reporter) IsLive() bool {
+	_, ok := r.generator.(LiveReportGenerator)
+	return ok
+}
+
+// 실행 이벤트를 w에 바로 기록하는 리스너 반환 (IsLive가 true인 경우에만 사용)
+func (r *Reporter) Listen(w io.Writer) RunListener {
+	return r.generator.(LiveReportGenerator).Listen(w)
 }
 
-func (r *Reporter) generateText(summaries []*TestSummary) (string, error) {
+// 기본 출력 대신 사용자 템플릿 사용 (템플릿을 지원하지 않는 형식이면 오류)
+func (r *Reporter) UseTemplate(text string) error {
+	templated, ok := r.generator.(TemplateReportGenerator)
+	if !ok {
+		return fmt.Errorf("%s 리포트는 템플릿을 지원하지 않습니다", r.format)
+	}
+
+	generator, err := templated.WithTemplate(text)
+	if err != nil {
+		return fmt.Errorf("%s 템플릿 파싱 실패: %v", r.format, err)
+	}
+	r.generator = generator
+	return nil
+}
+
+// 파일 저장 시 기본 파일명 (예: junit-report.xml)
+func (r *Reporter) DefaultFilename() string {
+	return r.format + "-report." + r.generator.Extension()
+}
+
+// tmpl이 nil이면 기본 형식으로 출력
+type textReport struct {
+	tmpl *template.Template
+}
+
+func (textReport) Extension() string { return "txt" }
+
+// 사용자 템플릿으로 텍스트 리포트 생성 (-text-template)
+func (textReport) WithTemplate(text string) (ReportGenerator, error) {
+	t, err := template.New("report").Funcs(template.FuncMap(reportTemplateFuncs)).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	return textReport{tmpl: t}, nil
+}
+
+func (r textReport) Generate(summaries []*TestSummary) (string, error) {
 	var sb strings.Builder
+	if r.tmpl != nil {
+		if err := r.tmpl.Execute(&sb, newReportData(summaries)); err != nil {
+			return "", err
+		}
+		return sb.String(), nil
+	}
 
 	sb.WriteString("📊 상세 테스트 결과\n")
 	sb.WriteString("=" + strings.Repeat("=", 50) + "\n\n")
+	if len(summaries) > 0 && summaries[0].DryRun {
+		sb.WriteString("🔍 드라이런: 요청을 전송하지 않고 해석된 요청만 표시합니다\n\n")
+	}
 
 	for i, summary := range summaries {
 		sb.WriteString(fmt.Sprintf("[%d] %s\n", i+1, summary.CollectionName))
 		sb.WriteString(fmt.Sprintf("파일: %s\n", summary.FilePath))
 		sb.WriteString(fmt.Sprintf("실행시간: %.2fs\n", summary.TotalTime.Seconds()))
-		sb.WriteString(fmt.Sprintf("결과: %d개 성공, %d개 실패\n", summary.PassedTests, summary.FailedTests))
+		sb.WriteString(fmt.Sprintf("결과: %d개 성공, %d개 실패", summary.PassedTests, summary.FailedTests))
+		if summary.SkippedTests > 0 {
+			sb.WriteString(fmt.Sprintf(", %d개 건너뜀", summary.SkippedTests))
+		}
+		sb.WriteString("\n")
 		sb.WriteString("-" + strings.Repeat("-", 30) + "\n")
 
 		for j, result := range summary.Results {
 			status := "✅"
-			if !result.Success {
+			if result.Skipped {
+				status = "⏭️"
+			} else if !result.Success {
 				status = "❌"
 			}
 
 			sb.WriteString(fmt.Sprintf("  [%d.%d] %s %s\n", i+1, j+1, status, result.Name))
 			sb.WriteString(fmt.Sprintf("        %s %s\n", result.Method, result.URL))
-			sb.WriteString(fmt.Sprintf("        응답: HTTP %d (%.2fs)\n", result.StatusCode, result.ResponseTime.Seconds()))
+			if result.Skipped {
+				sb.WriteString(fmt.Sprintf("        건너뜀: %s\n\n", result.SkipReason))
+				continue
+			}
+			if summary.DryRun {
+				writeResolvedRequest(&sb, result)
+			} else {
+				sb.WriteString(fmt.Sprintf("        응답: HTTP %d (%.2fs)\n", result.StatusCode, result.ResponseTime.Seconds()))
+				if result.Timings != nil {
+					sb.WriteString(fmt.Sprintf("        타이밍: %s\n", result.Timings))
+				}
+			}
 
+			if len(result.Assertions) > 1 {
+				for _, assertion := range result.Assertions {
+					mark := "✔"
+					if !assertion.Passed {
+						mark = "✘"
+					}
+					sb.WriteString(fmt.Sprintf("        %s %s\n", mark, assertion.Name))
+				}
+			}
 			if !result.Success {
 				sb.WriteString(fmt.Sprintf("        오류: %s\n", result.ErrorMessage))
 			}
+			if result.Curl != "" {
+				sb.WriteString(fmt.Sprintf("        curl: %s\n", result.Curl))
+			}
 			sb.WriteString("\n")
 		}
+		if summary.Latency != nil {
+			writeLatencyStats(&sb, summary.Latency)
+		}
+		for _, violation := range summary.SLAViolations {
+			sb.WriteString(fmt.Sprintf("  ⏱️ %s\n", violation))
+		}
 		sb.WriteString("\n")
 	}
 
 	return sb.String(), nil
 }
 
-func (r *Reporter) printCSV(summaries []*TestSummary) {
-	content, _ := r.generateCSV(summaries)
-	fmt.Print(content)
-}
-
-func (r *Reporter) generateCSV(summaries []*TestSummary) (string, error) {
-	var sb strings.Builder
-	
-	// UTF-8 BOM 추가 (Excel에서 한글 제대로 표시하기 위함)
-	sb.WriteString("\uFEFF")
-	
-	// CSV 헤더
-	sb.WriteString("Collection,FilePath,TestName,Method,URL,StatusCode,Success,ResponseTime,ErrorMessage\n")
-	
-	// 각 컬렉션의 테스트 결과를 CSV 행으로 변환
-	for _, summary := range summaries {
-		for _, result := range summary.Results {
-			// CSV 필드 값들을 이스케이프 처리
-			collection := escapeCSV(summary.CollectionName)
-			filePath := escapeCSV(summary.FilePath)
-			testName := escapeCSV(result.Name)
-			method := escapeCSV(result.Method)
-			url := escapeCSV(result.URL)
-			statusCode := fmt.Sprintf("%d", result.StatusCode)
-			success := "true"
-			if !result.Success {
-				success = "false"
-			}
-			responseTime := fmt.Sprintf("%.3f", result.ResponseTime.Seconds())
-			errorMessage := escapeCSV(result.ErrorMessage)
-			
-			sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,%s,%s,%s,%s,%s\n",
-				collection, filePath, testName, method, url, statusCode, success, responseTime, errorMessage))
+// 컬렉션의 응답 시간 통계 출력 (전체, 폴더별, 요청별)
+func writeLatencyStats(sb *strings.Builder, latency *CollectionLatency) {
+	sb.WriteString("  ⏱️ 응답 시간 통계\n")
+	sb.WriteString(fmt.Sprintf("    전체: %s\n", latency.Overall))
+	if len(latency.Folders) > 0 {
+		sb.WriteString("    폴더별:\n")
+		for _, group := range latency.Folders {
+			sb.WriteString(fmt.Sprintf("      %s: %s\n", group.Name, group.LatencyStats))
 		}
 	}
-	
-	return sb.String(), nil
-}
-
-// CSV 필드 값에 대한 이스케이프 처리
-func escapeCSV(value string) string {
-	// 빈 값 처리
-	if value == "" {
-		return ""
-	}
-	
-	// 쌍따옴표, 쉼표, 줄바꿈이 포함된 경우 쌍따옴표로 감싸고 내부 쌍따옴표는 두 번 반복
-	if strings.Contains(value, "\"") || strings.Contains(value, ",") || strings.Contains(value, "\n") {
-		value = strings.ReplaceAll(value, "\"", "\"\"")
-		return "\"" + value + "\""
+	sb.WriteString("    요청별:\n")
+	for _, group := range latency.Requests {
+		sb.WriteString(fmt.Sprintf("      %s: %s\n", group.Name, group.LatencyStats))
 	}
-	
-	return value
 }
 
-func (r *Reporter) printJSON(summaries []*TestSummary) {
-	content, _ := r.generateJSON(summaries)
-	fmt.Print(content)
-}
-
-func (r *Reporter) generateJSON(summaries []*TestSummary) (string, error) {
-	data, err := json.MarshalIndent(summaries, "", "  ")
-	if err != nil {
-		return "", err
+// 드라이런 결과의 최종 요청 헤더와 본문 출력
+func writeResolvedRequest(sb *strings.Builder, result TestResult) {
+	keys := make([]string, 0, len(result.RequestHeaders))
+	for key := range result.RequestHeaders {
+		keys = append(keys, key)
 	}
-	return string(data), nil
-}
-
-func (r *Reporter) printHTML(summaries []*TestSummary) {
-	content, _ := r.generateHTML(summaries)
-	fmt.Print(content)
-}
+	sort.Strings(keys)
 
-func (r *Reporter) generateHTML(summaries []*TestSummary) (string, error) {
-	tmpl := `<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <title>Postman Collection Test Report</title>
-    <style>
-        body { font-family: Arial, sans-serif; margin: 20px; }
-        .header { background: #f5f5f5; padding: 20px; border-radius: 5px; margin-bottom: 20px; }
-        .collection { border: 1px solid #ddd; margin-bottom: 20px; border-radius: 5px; }
-        .collection-header { background: #f8f9fa; padding: 15px; border-bottom: 1px solid #ddd; }
-        .collection-name { font-size: 1.2em; font-weight: bold; margin: 0; }
-        .collection-stats { color: #666; margin-top: 5px; }
-        .test-item { padding: 10px 15px; border-bottom: 1px solid #eee; }
-        .test-item:last-child { border-bottom: none; }
-        .test-success { border-left: 4px solid #28a745; }
-        .test-failed { border-left: 4px solid #dc3545; }
-        .test-name { font-weight: bold; }
-        .test-details { color: #666; margin-top: 5px; }
-        .error-message { color: #dc3545; font-style: italic; margin-top: 5px; }
-        .summary { background: #e9ecef; padding: 15px; border-radius: 5px; }
-        .success-rate { font-size: 1.1em; font-weight: bold; }
-    </style>
-</head>
-<body>
-    <div class="header">
-        <h1>🧪 Postman Collection Test Report</h1>
-        <p>생성 시간: {{.Timestamp}}</p>
-    </div>
-
-    {{range $index, $summary := .Summaries}}
-    <div class="collection">
-        <div class="collection-header">
-            <h2 class="collection-name">{{$summary.CollectionName}}</h2>
-            <div class="collection-stats">
-                파일: {{$summary.FilePath}}<br>
-                실행시간: {{printf "%.2f" $summary.TotalTime.Seconds}}초 | 
-                총 {{$summary.TotalTests}}개 테스트 | 
-                성공: {{$summary.PassedTests}}개 | 
-                실패: {{$summary.FailedTests}}개
-            </div>
-        </div>
-        
-        {{range $summary.Results}}
-        <div class="test-item {{if .Success}}test-success{{else}}test-failed{{end}}">
-            <div class="test-name">
-                {{if .Success}}✅{{else}}❌{{end}} {{.Name}}
-            </div>
-            <div class="test-details">
-                {{.Method}} {{.URL}}<br>
-                응답: HTTP {{.StatusCode}} ({{printf "%.2f" .ResponseTime.Seconds}}초)
-            </div>
-            {{if not .Success}}
-            <div class="error-message">오류: {{.ErrorMessage}}</div>
-            {{end}}
-        </div>
-        {{end}}
-    </div>
-    {{end}}
-
-    <div class="summary">
-        <h3>📋 전체 요약</h3>
-        <p>총 {{.TotalCollections}}개 컬렉션, {{.TotalTests}}개 테스트</p>
-        <p class="success-rate">
-            성공률: {{printf "%.1f" .SuccessRate}}% 
-            ({{.TotalPassed}}개 성공 / {{.TotalFailed}}개 실패)
-        </p>
-    </div>
-</body>
-</html>`
-
-	// 통계 계산
-	data := struct {
-		Summaries        []*TestSummary
-		Timestamp        string
-		TotalCollections int
-		TotalTests       int
-		TotalPassed      int
-		TotalFailed      int
-		SuccessRate      float64
-	}{
-		Summaries:        summaries,
-		Timestamp:        time.Now().Format("2006-01-02 15:04:05"),
-		TotalCollections: len(summaries),
+	for _, key := range keys {
+		sb.WriteString(fmt.Sprintf("        %s: %s\n", key, result.RequestHeaders[key]))
 	}
-
-	for _, summary := range summaries {
-		data.TotalTests += summary.TotalTests
-		data.TotalPassed += summary.PassedTests
-		data.TotalFailed += summary.FailedTests
+	if result.RequestBody != "" {
+		sb.WriteString("        본문:\n")
+		for _, line := range strings.Split(result.RequestBody, "\n") {
+			sb.WriteString("          " + line + "\n")
+		}
 	}
+}
 
-	if data.TotalTests > 0 {
-		data.SuccessRate = float64(data.TotalPassed) / float64(data.TotalTests) * 100
-	}
+type jsonReport struct{}
 
-	t, err := template.New("report").Parse(tmpl)
-	if err != nil {
-		return "", err
-	}
+func (jsonReport) Extension() string { return "json" }
 
-	var sb strings.Builder
-	err = t.Execute(&sb, data)
+func (jsonReport) Generate(summaries []*TestSummary) (string, error) {
+	data, err := json.MarshalIndent(summaries, "", "  ")
 	if err != nil {
 		return "", err
 	}
-
-	return sb.String(), nil
-}
\ No newline at end of file
+	return string(data), nil
+}
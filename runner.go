@@ -7,12 +7,100 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptrace"
 	"strings"
 	"time"
 )
 
 type Runner struct {
-	client *http.Client
+	client     *http.Client
+	filter     *requestFilter          // 이름/태그 필터 (nil이면 모든 요청 실행)
+	dryRun     bool                    // true이면 요청을 전송하지 않고 해석 결과만 기록
+	curlShell  string                  // 비어있지 않으면 각 요청을 해당 셸용 curl 명령으로 기록
+	assertions *assertionSet           // 선언적 검증 (nil이면 상태 코드 2xx만 검사)
+	schemas    *schemaDirectory        // 요청 이름별 JSON Schema 검증 (선택사항)
+	snapshots  *snapshotStore          // 응답 본문 스냅샷 비교 (선택사항)
+	examples   *exampleVerifier        // 저장된 응답 예제와 비교 (선택사항)
+	openAPI    *openAPISpec            // OpenAPI 명세 적합성 검사 (선택사항)
+	sla        *slaPolicy              // 응답 시간 SLA와 성능 예산 (선택사항)
+	capture    bodyCapture             // 리포트에 기록할 응답 본문 범위 (기본값: 텍스트 전체, 바이너리는 크기와 해시)
+	redact     *redactor               // 리포트에 기록하기 전 민감 정보 마스킹 (nil이면 그대로 기록)
+	envVars    []Variable              // -environment 환경 파일의 변수 (컬렉션 변수보다 우선)
+	snapshot   *collectionSnapshots    // 실행 중인 컬렉션의 스냅샷 경로
+	variables  variableScope           // 실행 중인 컬렉션의 변수
+	secrets    []string                // secret 타입 변수 값 (마스킹 대상)
+	listener   RunListener             // 실행 이벤트 수신자 (선택사항)
+	onResult   func(result TestResult) // 요청 하나가 끝날 때마다 호출 (진행 표시용, 선택사항)
+}
+
+// 실행 중 발생하는 이벤트를 전달받는 인터페이스 (스트리밍 리포트 등)
+// 병렬 실행 시 여러 워커에서 동시에 호출될 수 있다
+type RunListener interface {
+	RunStarted(files []string, dryRun bool)
+	CollectionStarted(collection, file string, totalRequests int)
+	RequestStarted(collection, file string, request TestResult) // 이름/폴더/메서드/URL만 채워진 결과
+	RequestFinished(collection, file string, result TestResult)
+	CollectionFinished(summary *TestSummary)
+	RunFinished(summaries []*TestSummary)
+}
+
+// 여러 리스너에 같은 이벤트를 전달
+type runListeners []RunListener
+
+func (l runListeners) RunStarted(files []string, dryRun bool) {
+	for _, listener := range l {
+		listener.RunStarted(files, dryRun)
+	}
+}
+
+func (l runListeners) CollectionStarted(collection, file string, totalRequests int) {
+	for _, listener := range l {
+		listener.CollectionStarted(collection, file, totalRequests)
+	}
+}
+
+func (l runListeners) RequestStarted(collection, file string, request TestResult) {
+	for _, listener := range l {
+		listener.RequestStarted(collection, file, request)
+	}
+}
+
+func (l runListeners) RequestFinished(collection, file string, result TestResult) {
+	for _, listener := range l {
+		listener.RequestFinished(collection, file, result)
+	}
+}
+
+func (l runListeners) CollectionFinished(summary *TestSummary) {
+	for _, listener := range l {
+		listener.CollectionFinished(summary)
+	}
+}
+
+func (l runListeners) RunFinished(summaries []*TestSummary) {
+	for _, listener := range l {
+		listener.RunFinished(summaries)
+	}
+}
+
+// 상위 폴더에서 하위 요청으로 상속되는 실행 정보
+type itemScope struct {
+	folders []string // 상위 폴더 이름 (바깥쪽부터)
+	tags    []string // 상위 폴더 설명에 지정된 태그
+	auth    *Auth    // 가장 가까운 상위 폴더(또는 컬렉션)의 인증 설정
+}
+
+func (s itemScope) folderPath() string {
+	return strings.Join(s.folders, "/")
+}
+
+// 변수/인증/본문이 모두 해석된 최종 요청
+type preparedRequest struct {
+	req        *http.Request
+	url        string // 변수가 치환된 URL (해석되지 않은 변수는 {{ }} 형태 그대로 유지)
+	body       string
+	unresolved []string
+	authError  error // 지원하지 않는 인증 방식 (요청은 인증 없이 생성됨)
 }
 
 func NewRunner() *Runner {
@@ -38,105 +126,268 @@ func (r *Runner) LoadCollection(filepath string) (*Collection, error) {
 	return &collection, nil
 }
 
-// 컬렉션의 모든 요청 실행
-func (r *Runner) RunCollection(collection *Collection) *TestSummary {
+// 컬렉션의 모든 요청 실행 (file은 결과와 이벤트에 기록할 컬렉션 파일 경로)
+func (r *Runner) RunCollection(collection *Collection, file string) *TestSummary {
 	summary := &TestSummary{
-		StartTime: time.Now(),
-		Results:   make([]TestResult, 0),
+		CollectionName: collection.Info.Name,
+		FilePath:       file,
+		StartTime:      time.Now(),
+		DryRun:         r.dryRun,
+		Results:        make([]TestResult, 0),
+	}
+	variables := append(append([]Variable{}, collection.Variable...), r.envVars...)
+	r.variables = newVariableScope(variables)
+	r.secrets = secretValues(variables)
+	r.snapshot = r.snapshots.forCollection(file, func(result *TestResult, body string) string {
+		contentType := http.Header(result.ResponseHeaders).Get("Content-Type")
+		return string(r.redact.body(contentType, []byte(body), r.secrets))
+	})
+	if r.listener != nil {
+		r.listener.CollectionStarted(summary.CollectionName, file, countRequests(collection.Item))
 	}
 
 	// 모든 아이템을 재귀적으로 실행
-	r.executeItems(collection.Item, summary)
+	r.executeItems(collection.Item, summary, itemScope{auth: collection.Auth})
 
 	summary.EndTime = time.Now()
 	summary.TotalTime = summary.EndTime.Sub(summary.StartTime)
 	summary.TotalTests = len(summary.Results)
-	
+
 	for _, result := range summary.Results {
-		if result.Success {
+		if result.Skipped {
+			summary.SkippedTests++
+		} else if result.Success {
 			summary.PassedTests++
 		} else {
 			summary.FailedTests++
 		}
 	}
+	summary.TotalTests -= summary.SkippedTests
+	summary.Latency = newCollectionLatency(summary.Results)
+	summary.Coverage = r.openAPI.coverage(summary.Results)
+	summary.SLAViolations = r.sla.checkBudgets(summary.Results)
 
+	if r.listener != nil {
+		r.listener.CollectionFinished(summary)
+	}
 	return summary
 }
 
 // 아이템들을 재귀적으로 실행 (폴더 구조 지원)
-func (r *Runner) executeItems(items []Item, summary *TestSummary) {
+// scope에는 상위 폴더의 태그와 인증 설정이 담겨 하위 요청에 상속된다
+func (r *Runner) executeItems(items []Item, summary *TestSummary, scope itemScope) {
 	for _, item := range items {
+		itemScope := itemScope{
+			folders: scope.folders,
+			tags:    append(append([]string{}, scope.tags...), itemTags(item)...),
+			auth:    scope.auth,
+		}
+		if item.Auth != nil {
+			itemScope.auth = item.Auth
+		}
+
 		if item.Request != nil {
-			// 요청이 있는 아이템 실행
-			result := r.executeRequest(item)
+			// 요청이 있는 아이템 실행 (필터에 걸리면 건너뜀으로 기록)
+			var result TestResult
+			if reason, skip := r.filter.skipReason(item.Name, itemScope.tags); skip {
+				result = r.skippedResult(item, reason)
+			} else {
+				if r.listener != nil {
+					started := TestResult{
+						Name:   item.Name,
+						Folder: scope.folderPath(),
+						Method: item.Request.Method,
+						URL:    r.variables.resolve(r.parseURL(item.Request.URL), nil),
+					}
+					r.redact.apply(&started, r.secrets)
+					r.listener.RequestStarted(summary.CollectionName, summary.FilePath, started)
+				}
+				result = r.executeRequest(item, itemScope)
+			}
+			result.Folder = scope.folderPath()
+			// 결과가 리포트나 이벤트로 나가기 전에 민감 정보를 가림
+			r.redact.apply(&result, r.secrets)
 			summary.Results = append(summary.Results, result)
+			if r.listener != nil {
+				r.listener.RequestFinished(summary.CollectionName, summary.FilePath, result)
+			}
+			if r.onResult != nil {
+				r.onResult(result)
+			}
 		} else if len(item.Item) > 0 {
 			// 중첩된 아이템들 재귀 실행
-			r.executeItems(item.Item, summary)
+			itemScope.folders = append(append([]string{}, scope.folders...), item.Name)
+			r.executeItems(item.Item, summary, itemScope)
 		}
 	}
 }
 
-// 개별 요청 실행
-func (r *Runner) executeRequest(item Item) TestResult {
-	result := TestResult{
+// 필터로 제외된 요청의 결과
+func (r *Runner) skippedResult(item Item, reason string) TestResult {
+	return TestResult{
 		Name:           item.Name,
-		Timestamp:      time.Now(),
+		Method:         item.Request.Method,
+		URL:            r.variables.resolve(r.parseURL(item.Request.URL), nil),
+		Skipped:        true,
+		SkipReason:     reason,
 		RequestHeaders: make(map[string]string),
+		Timestamp:      time.Now(),
 	}
+}
 
-	startTime := time.Now()
+// 실행될 요청 수를 재귀적으로 계산
+func countRequests(items []Item) int {
+	count := 0
+	for _, item := range items {
+		if item.Request != nil {
+			count++
+		} else if len(item.Item) > 0 {
+			count += countRequests(item.Item)
+		}
+	}
+	return count
+}
 
-	// URL 파싱
-	url := r.parseURL(item.Request.URL)
-	result.URL = url
-	result.Method = item.Request.Method
+// 요청 아이템으로부터 변수/인증/본문이 적용된 HTTP 요청 생성
+// 실제 실행과 드라이런이 같은 해석 결과를 사용하도록 공통으로 사용한다
+func (r *Runner) buildRequest(item Item, scope itemScope) (*preparedRequest, error) {
+	prepared := &preparedRequest{}
+	request := item.Request
+
+	// URL 파싱 및 변수 치환
+	url := r.variables.resolve(r.parseURL(request.URL), &prepared.unresolved)
+	prepared.url = url
+
+	// 본문 생성
+	contentType := ""
+	if request.Body != nil {
+		switch request.Body.Mode {
+		case "urlencoded":
+			prepared.body = encodeForm(request.Body.URLEncoded, r.variables, &prepared.unresolved)
+			contentType = "application/x-www-form-urlencoded"
+		default:
+			prepared.body = r.variables.resolve(request.Body.Raw, &prepared.unresolved)
+			// Content-Type 설정 (JSON body가 있는 경우)
+			if request.Body.Options != nil && request.Body.Options.Raw != nil {
+				if request.Body.Options.Raw.Language == "json" {
+					contentType = "application/json"
+				}
+			}
+		}
+	}
 
-	// HTTP 요청 생성
 	var body io.Reader
-	if item.Request.Body != nil && item.Request.Body.Raw != "" {
-		body = strings.NewReader(item.Request.Body.Raw)
+	if prepared.body != "" {
+		body = strings.NewReader(prepared.body)
 	}
 
-	req, err := http.NewRequest(item.Request.Method, url, body)
+	req, err := http.NewRequest(request.Method, url, body)
+	if err != nil {
+		return prepared, err
+	}
+	prepared.req = req
+
+	// 헤더 설정
+	for _, header := range request.Header {
+		if header.Disabled || header.Key == "" || header.Value == "" {
+			continue
+		}
+		req.Header.Set(r.variables.resolve(header.Key, &prepared.unresolved), r.variables.resolve(header.Value, &prepared.unresolved))
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	// 인증 설정 (요청에 지정된 인증이 상위 설정보다 우선)
+	auth := scope.auth
+	if request.Auth != nil {
+		auth = request.Auth
+	}
+	rawQuery := req.URL.RawQuery
+	prepared.authError = applyAuth(req, auth, r.variables, &prepared.unresolved)
+	if req.URL.RawQuery != rawQuery {
+		// API 키가 쿼리 파라미터로 추가된 경우
+		prepared.url = req.URL.String()
+	}
+
+	return prepared, nil
+}
+
+// 개별 요청 실행
+func (r *Runner) executeRequest(item Item, scope itemScope) TestResult {
+	result := TestResult{
+		Name:           item.Name,
+		Folder:         scope.folderPath(),
+		Method:         item.Request.Method,
+		Timestamp:      time.Now(),
+		RequestHeaders: make(map[string]string),
+	}
+
+	// HTTP 요청 생성
+	prepared, err := r.buildRequest(item, scope)
+	result.Unresolved = prepared.unresolved
+	result.URL = prepared.url
 	if err != nil {
 		result.Success = false
 		result.ErrorMessage = fmt.Sprintf("요청 생성 실패: %v", err)
+		if len(result.Unresolved) > 0 {
+			result.ErrorMessage += fmt.Sprintf(" (해결되지 않은 변수: %s)", strings.Join(result.Unresolved, ", "))
+		}
 		return result
 	}
 
-	// 헤더 설정
-	for _, header := range item.Request.Header {
-		if header.Key != "" && header.Value != "" {
-			req.Header.Set(header.Key, header.Value)
-			result.RequestHeaders[header.Key] = header.Value
-		}
+	// 기대 상태 코드 (@expect 주석 또는 저장 예제)
+	expected, err := expectedStatus(item)
+	if err != nil {
+		result.Success = false
+		result.ErrorMessage = err.Error()
+		return result
 	}
 
-	// Content-Type 설정 (JSON body가 있는 경우)
-	if item.Request.Body != nil && item.Request.Body.Mode == "raw" {
-		if item.Request.Body.Options != nil && item.Request.Body.Options.Raw != nil {
-			if item.Request.Body.Options.Raw.Language == "json" {
-				req.Header.Set("Content-Type", "application/json")
-			}
+	req := prepared.req
+	result.RequestBody = prepared.body
+	for key, values := range req.Header {
+		result.RequestHeaders[key] = strings.Join(values, ", ")
+	}
+	if r.curlShell != "" {
+		result.Curl = renderCurl(req, prepared.url, prepared.body, r.curlShell, r.redact, r.secrets)
+	}
+
+	// 드라이런: 전송하지 않고 해석되지 않은 변수와 인증 설정만 검사
+	if r.dryRun {
+		var problems []string
+		if len(result.Unresolved) > 0 {
+			problems = append(problems, fmt.Sprintf("해결되지 않은 변수: %s", strings.Join(result.Unresolved, ", ")))
 		}
+		if prepared.authError != nil {
+			problems = append(problems, prepared.authError.Error())
+		}
+		result.Success = len(problems) == 0
+		result.ErrorMessage = strings.Join(problems, "; ")
+		return result
 	}
 
-	// 요청 실행
+	// 요청 실행 (응답 시간은 요청 생성 시간을 빼고 전송 시작부터 본문 수신 완료까지)
+	tracer := newRequestTracer()
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), tracer.clientTrace()))
 	resp, err := r.client.Do(req)
 	if err != nil {
 		result.Success = false
 		result.ErrorMessage = fmt.Sprintf("요청 실행 실패: %v", err)
-		result.ResponseTime = time.Since(startTime)
+		result.Timings = tracer.timings(time.Now())
+		result.ResponseTime = result.Timings.Total
 		return result
 	}
 	defer resp.Body.Close()
 
-	result.ResponseTime = time.Since(startTime)
 	result.StatusCode = resp.StatusCode
+	result.ResponseHeaders = resp.Header
+	result.HTTPVersion = resp.Proto
 
 	// 응답 본문 읽기
 	bodyBytes, err := io.ReadAll(resp.Body)
+	result.Timings = tracer.timings(time.Now())
+	result.ResponseTime = result.Timings.Total
 	if err != nil {
 		result.Success = false
 		result.ErrorMessage = fmt.Sprintf("응답 읽기 실패: %v", err)
@@ -144,12 +395,27 @@ func (r *Runner) executeRequest(item Item) TestResult {
 	}
 	result.ResponseBody = string(bodyBytes)
 
-	// 성공 여부 판단 (2xx 상태코드)
-	result.Success = resp.StatusCode >= 200 && resp.StatusCode < 300
-
-	if !result.Success {
-		result.ErrorMessage = fmt.Sprintf("HTTP %d: %s", resp.StatusCode, resp.Status)
+	// 성공 여부 판단 (기본은 2xx 상태코드, @expect 주석이나 저장 예제로 기대 상태 코드 지정 가능,
+	// -assertions 파일, -schemas 디렉토리, -snapshot-dir 스냅샷, -verify-examples 예제, -openapi 명세 검증,
+	// -max-response-time/-sla 응답 시간 SLA 추가)
+	r.assertions.evaluate(&result, expected)
+	r.schemas.evaluate(&result)
+	r.snapshot.evaluate(&result)
+	r.examples.evaluate(item, &result)
+	r.openAPI.evaluate(&result)
+	r.sla.evaluate(&result)
+	if prepared.authError != nil {
+		// 인증 없이 보낸 응답도 기록하되 설정 문제로 실패 처리
+		result.Assertions = append(result.Assertions, AssertionResult{Name: "인증 설정", Message: prepared.authError.Error()})
 	}
+	settleAssertions(&result)
+
+	// 검증이 끝난 뒤 리포트에 남길 본문만 가리고 줄임 (-redact, -max-body-capture, -binary-bodies, -no-bodies)
+	// 본문 크기와 해시는 가리기 전의 실제 응답 기준
+	contentType := http.Header(result.ResponseHeaders).Get("Content-Type")
+	r.capture.apply(&result, bodyBytes, func(body []byte) []byte {
+		return r.redact.body(contentType, body, r.secrets)
+	})
 
 	return result
 }
@@ -213,4 +479,4 @@ func (r *Runner) buildURL(urlObj map[string]interface{}) string {
 	}
 
 	return buf.String()
-}
\ No newline at end of file
+}
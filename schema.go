@@ -0,0 +1,198 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// 스키마 검증 오류 하나 (JSON 포인터 경로와 메시지)
+type SchemaError struct {
+	Path    string `json:"path"` // 응답 본문 내 위치 (예: /data/0/id, 루트는 빈 문자열)
+	Message string `json:"message"`
+}
+
+// 검증 메시지에 포함할 최대 오류 개수 (전체 오류는 TestResult.SchemaErrors에 기록)
+const maxSchemaErrorsInMessage = 5
+
+// JSON Schema 컴파일러 (draft-07, 2019-09, 2020-12 등은 $schema로 판별, 없으면 2020-12)
+// $ref는 로컬 파일만 따라가며 네트워크에서 스키마를 내려받지 않는다
+// 여러 워커가 함께 사용하므로 컴파일과 캐시는 잠금으로 보호한다
+type schemaCompiler struct {
+	mu       sync.Mutex
+	compiler *jsonschema.Compiler
+	cache    map[string]*jsonschema.Schema
+}
+
+func newSchemaCompiler() *schemaCompiler {
+	compiler := jsonschema.NewCompiler()
+	compiler.UseLoader(jsonschema.SchemeURLLoader{"file": jsonschema.FileLoader{}})
+	return &schemaCompiler{compiler: compiler, cache: make(map[string]*jsonschema.Schema)}
+}
+
+// 스키마 파일 컴파일 (같은 파일은 한 번만 컴파일)
+func (c *schemaCompiler) compile(path string) (*jsonschema.Schema, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	return c.compileLocation(abs)
+}
+
+// 파일 경로 또는 URL(#JSON 포인터 포함)의 스키마 컴파일
+func (c *schemaCompiler) compileLocation(location string) (*jsonschema.Schema, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if schema, ok := c.cache[location]; ok {
+		return schema, nil
+	}
+	schema, err := c.compiler.Compile(location)
+	if err != nil {
+		return nil, err
+	}
+	c.cache[location] = schema
+	return schema, nil
+}
+
+// 응답 본문을 스키마로 검증한 결과 (name은 검증 항목 이름에 표시할 스키마 파일명)
+func validateSchema(schema *jsonschema.Schema, name string, result *TestResult) AssertionResult {
+	assertion := AssertionResult{Name: "JSON Schema " + name}
+
+	document, err := jsonschema.UnmarshalJSON(strings.NewReader(result.ResponseBody))
+	if err != nil {
+		assertion.Message = fmt.Sprintf("응답 본문이 JSON이 아님: %v", err)
+		return assertion
+	}
+
+	err = schema.Validate(document)
+	if err == nil {
+		assertion.Passed = true
+		return assertion
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		assertion.Message = fmt.Sprintf("스키마 검증 실패: %v", err)
+		return assertion
+	}
+
+	schemaErrors := schemaErrorList(validationErr)
+	result.SchemaErrors = append(result.SchemaErrors, schemaErrors...)
+
+	var messages []string
+	for i, schemaErr := range schemaErrors {
+		if i == maxSchemaErrorsInMessage {
+			messages = append(messages, fmt.Sprintf("외 %d개", len(schemaErrors)-i))
+			break
+		}
+		messages = append(messages, schemaErr.String())
+	}
+	assertion.Message = fmt.Sprintf("스키마 %s 불일치: %s", name, strings.Join(messages, ", "))
+	return assertion
+}
+
+// 검증 오류 트리에서 실제 원인(하위 오류가 없는 항목)만 모음
+// 트리의 순서는 실행마다 달라질 수 있어 리포트가 매번 같도록 경로순으로 정렬한다
+func schemaErrorList(err *jsonschema.ValidationError) []SchemaError {
+	var list []SchemaError
+	var collect func(unit *jsonschema.OutputUnit)
+	collect = func(unit *jsonschema.OutputUnit) {
+		if len(unit.Errors) == 0 {
+			if unit.Error != nil {
+				list = append(list, SchemaError{Path: unit.InstanceLocation, Message: unit.Error.String()})
+			}
+			return
+		}
+		for i := range unit.Errors {
+			collect(&unit.Errors[i])
+		}
+	}
+	collect(err.DetailedOutput())
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Path != list[j].Path {
+			return list[i].Path < list[j].Path
+		}
+		return list[i].Message < list[j].Message
+	})
+
+	if len(list) == 0 {
+		list = append(list, SchemaError{Message: err.Error()})
+	}
+	return list
+}
+
+func (e SchemaError) String() string {
+	path := e.Path
+	if path == "" {
+		path = "/"
+	}
+	return path + ": " + e.Message
+}
+
+// -schemas 디렉토리에서 요청 이름으로 스키마를 찾아 검증
+// <폴더 경로>/<요청 이름>.schema.json, <폴더 경로>/<요청 이름>.json, <요청 이름>.schema.json, <요청 이름>.json 순서로 찾는다
+type schemaDirectory struct {
+	dir      string
+	compiler *schemaCompiler
+}
+
+func newSchemaDirectory(dir string, compiler *schemaCompiler) (*schemaDirectory, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("스키마 디렉토리를 찾을 수 없습니다: %s", dir)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("-schemas에는 디렉토리를 지정해야 합니다: %s", dir)
+	}
+	return &schemaDirectory{dir: dir, compiler: compiler}, nil
+}
+
+// 요청에 대응하는 스키마 파일 경로 (없으면 빈 문자열)
+func (d *schemaDirectory) find(result *TestResult) string {
+	var candidates []string
+	if result.Folder != "" {
+		folder := filepath.Join(d.dir, filepath.FromSlash(result.Folder))
+		candidates = append(candidates,
+			filepath.Join(folder, result.Name+".schema.json"),
+			filepath.Join(folder, result.Name+".json"))
+	}
+	candidates = append(candidates,
+		filepath.Join(d.dir, result.Name+".schema.json"),
+		filepath.Join(d.dir, result.Name+".json"))
+
+	for _, path := range candidates {
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+	}
+	return ""
+}
+
+// 스키마 파일이 있는 요청만 검증 결과 추가 (nil이면 아무것도 하지 않음)
+func (d *schemaDirectory) evaluate(result *TestResult) {
+	if d == nil {
+		return
+	}
+	path := d.find(result)
+	if path == "" {
+		return
+	}
+
+	name := filepath.Base(path)
+	schema, err := d.compiler.compile(path)
+	if err != nil {
+		result.Assertions = append(result.Assertions, AssertionResult{
+			Name:    "JSON Schema " + name,
+			Message: fmt.Sprintf("스키마 로드 실패: %v", err),
+		})
+		return
+	}
+	result.Assertions = append(result.Assertions, validateSchema(schema, name, result))
+}
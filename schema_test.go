@@ -0,0 +1,118 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func writeSchemaFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSchemaDirectoryLocalRefs(t *testing.T) {
+	dir := t.TempDir()
+	writeSchemaFile(t, filepath.Join(dir, "common", "user.json"), `{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"type": "object",
+		"required": ["id", "email"],
+		"properties": {"id": {"type": "integer"}, "email": {"type": "string"}},
+		"definitions": {"role": {"enum": ["admin", "member"]}}
+	}`)
+	writeSchemaFile(t, filepath.Join(dir, "Users", "Get user.schema.json"), `{
+		"type": "object",
+		"properties": {
+			"data": {"$ref": "../common/user.json"},
+			"role": {"$ref": "../common/user.json#/definitions/role"}
+		}
+	}`)
+	writeSchemaFile(t, filepath.Join(dir, "Get user.json"), `{"type": "array"}`)
+
+	directory, err := newSchemaDirectory(dir, newSchemaCompiler())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		folder string
+		body   string
+		passed bool
+		errors []SchemaError
+	}{
+		{"valid", "Users", `{"data": {"id": 1, "email": "a@x"}, "role": "admin"}`, true, nil},
+		{"errors from referenced files", "Users", `{"data": {"id": "1"}, "role": "owner"}`, false, []SchemaError{
+			{Path: "/data", Message: "missing property 'email'"},
+			{Path: "/data/id", Message: "got string, want integer"},
+			{Path: "/role", Message: "value must be one of 'admin', 'member'"},
+		}},
+		{"root fallback without folder file", "Orders", `[]`, true, nil},
+	}
+	for _, tt := range tests {
+		result := TestResult{Name: "Get user", Folder: tt.folder, ResponseBody: tt.body}
+		directory.evaluate(&result)
+		if len(result.Assertions) != 1 || result.Assertions[0].Passed != tt.passed {
+			t.Errorf("%s: assertions = %+v", tt.name, result.Assertions)
+			continue
+		}
+		if !reflect.DeepEqual(result.SchemaErrors, tt.errors) {
+			t.Errorf("%s: schema errors:\n got  %+v\n want %+v", tt.name, result.SchemaErrors, tt.errors)
+		}
+	}
+
+	result := TestResult{Name: "No schema", ResponseBody: `{}`}
+	directory.evaluate(&result)
+	if len(result.Assertions) != 0 {
+		t.Errorf("request without a schema file got assertions: %+v", result.Assertions)
+	}
+}
+
+// 원격 $ref는 내려받지 않고 로드 실패로 기록해야 한다
+func TestSchemaCompilerDoesNotFetchRemoteRefs(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{"type": "object"}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	writeSchemaFile(t, filepath.Join(dir, "Remote.json"), `{"$ref": "`+server.URL+`/user.json"}`)
+
+	directory, err := newSchemaDirectory(dir, newSchemaCompiler())
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := TestResult{Name: "Remote", ResponseBody: `{}`}
+	directory.evaluate(&result)
+
+	if len(result.Assertions) != 1 || result.Assertions[0].Passed || !strings.HasPrefix(result.Assertions[0].Message, "스키마 로드 실패") {
+		t.Errorf("assertions = %+v, want a schema load failure", result.Assertions)
+	}
+	if n := atomic.LoadInt32(&requests); n != 0 {
+		t.Errorf("schema compiler sent %d network requests", n)
+	}
+}
+
+func TestNewSchemaDirectoryErrors(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "schema.json")
+	writeSchemaFile(t, file, `{}`)
+
+	if _, err := newSchemaDirectory(filepath.Join(t.TempDir(), "missing"), newSchemaCompiler()); err == nil {
+		t.Error("missing directory accepted")
+	}
+	if _, err := newSchemaDirectory(file, newSchemaCompiler()); err == nil || !strings.Contains(err.Error(), "디렉토리를 지정") {
+		t.Errorf("file as -schemas error = %v", err)
+	}
+}
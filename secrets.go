@@ -0,0 +1,116 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const maskedValue = "****"
+
+// 이보다 짧은 secret 값은 가리지 않음 ("1", "id", "true"처럼 흔한 값이 상태 코드, URL, JSON 키까지 가리지 않도록)
+const minSecretLength = 6
+
+// 이름만으로 민감 정보로 간주하는 헤더
+var sensitiveHeaders = map[string]bool{
+	"authorization":       true,
+	"proxy-authorization": true,
+	"cookie":              true,
+	"set-cookie":          true,
+	"x-api-key":           true,
+	"api-key":             true,
+	"apikey":              true,
+	"x-auth-token":        true,
+	"x-access-token":      true,
+	"x-csrf-token":        true,
+}
+
+// 헤더 이름이 인증 정보/토큰을 담는지 판단
+func isSensitiveHeader(name string) bool {
+	lower := strings.ToLower(name)
+	if sensitiveHeaders[lower] {
+		return true
+	}
+	for _, keyword := range []string{"token", "secret", "password", "api-key", "apikey"} {
+		if strings.Contains(lower, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// 헤더 값 마스킹 (Authorization은 "Bearer ****"처럼 인증 방식은 남김)
+func maskHeaderValue(name, value string) string {
+	if strings.EqualFold(name, "authorization") || strings.EqualFold(name, "proxy-authorization") {
+		if scheme, _, ok := strings.Cut(value, " "); ok {
+			return scheme + " " + maskedValue
+		}
+	}
+	return maskedValue
+}
+
+// secret 타입으로 지정된 변수들의 값 목록
+func secretValues(variables []Variable) []string {
+	var values []string
+	for _, v := range variables {
+		if strings.EqualFold(v.Type, "secret") {
+			if value := stringValue(v.Value); utf8.RuneCountInString(value) >= minSecretLength {
+				values = append(values, value)
+			}
+		}
+	}
+	// 긴 값부터 치환해야 다른 비밀 값의 일부만 가려지는 일이 없음
+	sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+	return values
+}
+
+// 문자열에 포함된 비밀 값들을 마스킹
+// 더 긴 단어의 일부로 나타난 경우(앞뒤가 글자나 숫자)는 같은 값이 아니므로 그대로 둔다
+func maskSecrets(text string, secrets []string) string {
+	for _, secret := range secrets {
+		if !strings.Contains(text, secret) {
+			continue
+		}
+		var buf strings.Builder
+		last := 0
+		for start := 0; ; {
+			i := strings.Index(text[start:], secret)
+			if i < 0 {
+				break
+			}
+			i += start
+			end := i + len(secret)
+			if tokenBoundary(text[:i], secret, false) && tokenBoundary(text[end:], secret, true) {
+				buf.WriteString(text[last:i])
+				buf.WriteString(maskedValue)
+				last = end
+			}
+			start = end
+		}
+		buf.WriteString(text[last:])
+		text = buf.String()
+	}
+	return text
+}
+
+// secret 값 바로 앞(after=false) 또는 뒤(after=true)가 단어 경계인지
+// 값이 글자/숫자로 시작하거나 끝나지 않으면 경계를 따지지 않는다
+func tokenBoundary(neighbour, secret string, after bool) bool {
+	var edge, next rune
+	if after {
+		edge, _ = utf8.DecodeLastRuneInString(secret)
+		next, _ = utf8.DecodeRuneInString(neighbour)
+	} else {
+		edge, _ = utf8.DecodeRuneInString(secret)
+		next, _ = utf8.DecodeLastRuneInString(neighbour)
+	}
+	if neighbour == "" || !isWordRune(edge) {
+		return true
+	}
+	return !isWordRune(next)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
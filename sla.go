@@ -0,0 +1,239 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// -sla 파일 (YAML 또는 JSON)
+//
+//	max_response_time: 1s         # 모든 요청의 기본 제한 (-max-response-time이 있으면 그 값 사용)
+//	requests:
+//	  - folder: Users             # 폴더(하위 포함) 또는 요청 이름별 제한
+//	    max_response_time: 300ms
+//	  - name: Search
+//	    max_response_time: 2s
+//	budgets:
+//	  - folder: Users             # 폴더 전체의 응답 시간 통계 예산 (name, folder 모두 생략하면 컬렉션 전체)
+//	    p95: 300ms
+//	    p99: 800ms
+type slaFile struct {
+	MaxResponseTime *durationValue `yaml:"max_response_time"`
+	Requests        []slaRule      `yaml:"requests"`
+	Budgets         []slaBudget    `yaml:"budgets"`
+}
+
+// 요청 하나의 응답 시간 제한
+type slaRule struct {
+	Name            string         `yaml:"name"`
+	Folder          string         `yaml:"folder"`
+	MaxResponseTime *durationValue `yaml:"max_response_time"`
+}
+
+// 여러 요청의 응답 시간 통계 예산
+type slaBudget struct {
+	Name   string         `yaml:"name"`
+	Folder string         `yaml:"folder"`
+	P50    *durationValue `yaml:"p50"`
+	P90    *durationValue `yaml:"p90"`
+	P95    *durationValue `yaml:"p95"`
+	P99    *durationValue `yaml:"p99"`
+	Mean   *durationValue `yaml:"mean"`
+	Max    *durationValue `yaml:"max"`
+}
+
+// 응답 시간 SLA (nil이면 검사하지 않음)
+// 위반은 기능 실패와 구분해서 집계한다 (AssertionResult.SLA, TestSummary.SLAViolations)
+type slaPolicy struct {
+	global  time.Duration // 0이면 기본 제한 없음
+	rules   []slaRule
+	budgets []slaBudget
+}
+
+// -max-response-time 값과 -sla 파일로 SLA 생성 (둘 다 없으면 nil)
+func newSLAPolicy(maxResponseTime, path string) (*slaPolicy, error) {
+	if maxResponseTime == "" && path == "" {
+		return nil, nil
+	}
+	policy := &slaPolicy{}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("SLA 파일 읽기 실패: %v", err)
+		}
+		var file slaFile
+		if err := yaml.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("SLA 파일 파싱 실패: %s: %v", path, err)
+		}
+		if file.MaxResponseTime != nil {
+			policy.global = time.Duration(*file.MaxResponseTime)
+		}
+		for i, rule := range file.Requests {
+			if rule.Name == "" && rule.Folder == "" {
+				return nil, fmt.Errorf("%s: requests[%d]: name 또는 folder 중 하나는 지정해야 합니다", path, i)
+			}
+			if rule.MaxResponseTime == nil {
+				return nil, fmt.Errorf("%s: requests[%d]: max_response_time을 지정해야 합니다", path, i)
+			}
+			rule.Folder = strings.Trim(rule.Folder, "/")
+			policy.rules = append(policy.rules, rule)
+		}
+		for i, budget := range file.Budgets {
+			if len(budget.limits()) == 0 {
+				return nil, fmt.Errorf("%s: budgets[%d]: p50, p90, p95, p99, mean, max 중 하나는 지정해야 합니다", path, i)
+			}
+			budget.Folder = strings.Trim(budget.Folder, "/")
+			policy.budgets = append(policy.budgets, budget)
+		}
+	}
+
+	if maxResponseTime != "" {
+		limit, err := parseDurationValue(maxResponseTime)
+		if err != nil {
+			return nil, fmt.Errorf("잘못된 -max-response-time 값: %v", err)
+		}
+		policy.global = time.Duration(limit)
+	}
+	return policy, nil
+}
+
+// 요청에 적용할 응답 시간 제한 (이름이 일치하는 규칙 → 가장 깊은 폴더 규칙 → 기본값 순, 없으면 0)
+func (p *slaPolicy) limitFor(result *TestResult) time.Duration {
+	limit := p.global
+	depth := -1
+	for _, rule := range p.rules {
+		if !matchesScope(rule.Name, rule.Folder, result) {
+			continue
+		}
+		specificity := strings.Count(rule.Folder, "/") + 1
+		if rule.Folder == "" {
+			specificity = 0
+		}
+		if rule.Name != "" {
+			specificity += 1000
+		}
+		if specificity > depth {
+			limit, depth = time.Duration(*rule.MaxResponseTime), specificity
+		}
+	}
+	return limit
+}
+
+// 응답을 받은 요청의 응답 시간 제한 검사 결과 추가 (nil이면 아무것도 하지 않음)
+func (p *slaPolicy) evaluate(result *TestResult) {
+	if p == nil {
+		return
+	}
+	limit := p.limitFor(result)
+	if limit <= 0 {
+		return
+	}
+
+	assertion := AssertionResult{
+		Name:   fmt.Sprintf("SLA 응답 시간 ≤ %s", formatDuration(limit)),
+		Passed: result.ResponseTime <= limit,
+		SLA:    true,
+	}
+	if !assertion.Passed {
+		assertion.Message = fmt.Sprintf("SLA 초과: 응답 시간 %s > %s", formatDuration(result.ResponseTime), formatDuration(limit))
+		result.SLAExceeded = true
+	}
+	result.Assertions = append(result.Assertions, assertion)
+}
+
+// 컬렉션 실행이 끝난 뒤 응답 시간 통계 예산 검사 (위반 목록 반환)
+func (p *slaPolicy) checkBudgets(results []TestResult) []string {
+	if p == nil {
+		return nil
+	}
+
+	var violations []string
+	for _, budget := range p.budgets {
+		var durations []time.Duration
+		for i := range results {
+			if hasLatency(results[i]) && matchesScope(budget.Name, budget.Folder, &results[i]) {
+				durations = append(durations, results[i].ResponseTime)
+			}
+		}
+		if len(durations) == 0 {
+			continue
+		}
+
+		stats := newLatencyStats(durations)
+		values := map[string]time.Duration{
+			"p50": stats.P50, "p90": stats.P90, "p95": stats.P95, "p99": stats.P99, "mean": stats.Mean, "max": stats.Max,
+		}
+		for _, limit := range budget.limits() {
+			if actual := values[limit.name]; actual > limit.value {
+				violations = append(violations, fmt.Sprintf("SLA 초과: %s %s %s > %s (요청 %d개)",
+					budget.scope(), limit.name, formatDuration(actual), formatDuration(limit.value), len(durations)))
+			}
+		}
+	}
+	return violations
+}
+
+type budgetLimit struct {
+	name  string
+	value time.Duration
+}
+
+// 지정된 통계 항목별 제한 (p50, p90, p95, p99, mean, max 순)
+func (b slaBudget) limits() []budgetLimit {
+	var limits []budgetLimit
+	for _, entry := range []struct {
+		name  string
+		value *durationValue
+	}{{"p50", b.P50}, {"p90", b.P90}, {"p95", b.P95}, {"p99", b.P99}, {"mean", b.Mean}, {"max", b.Max}} {
+		if entry.value != nil {
+			limits = append(limits, budgetLimit{entry.name, time.Duration(*entry.value)})
+		}
+	}
+	return limits
+}
+
+// 예산 대상 표시 (예: "Users 폴더", "Users / Search", "컬렉션 전체")
+func (b slaBudget) scope() string {
+	switch {
+	case b.Folder != "" && b.Name != "":
+		return b.Folder + " / " + b.Name
+	case b.Folder != "":
+		return b.Folder + " 폴더"
+	case b.Name != "":
+		return b.Name
+	default:
+		return "컬렉션 전체"
+	}
+}
+
+// 이름과 폴더 조건에 맞는 요청인지 (이름이 같고 폴더가 같거나 하위 폴더, 빈 조건은 모두 일치)
+func matchesScope(name, folder string, result *TestResult) bool {
+	if name != "" && name != result.Name {
+		return false
+	}
+	if folder != "" && result.Folder != folder && !strings.HasPrefix(result.Folder, folder+"/") {
+		return false
+	}
+	return true
+}
+
+// SLA 검증 외에 실패한 항목이 있는지 (기능 실패 여부)
+func hasFunctionalFailure(result TestResult) bool {
+	if result.Success || result.Skipped {
+		return false
+	}
+	if !result.SLAExceeded {
+		return true
+	}
+	for _, assertion := range result.Assertions {
+		if !assertion.Passed && !assertion.SLA {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeSLAFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "sla.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestNewSLAPolicyValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"budget without limits", "budgets: [{folder: x}]", "budgets[0]"},
+		{"rule without scope", "requests: [{max_response_time: 1s}]", "name 또는 folder"},
+		{"rule without limit", "requests: [{folder: Users}]", "max_response_time"},
+		{"bad duration", "max_response_time: soon", "시간 형식 오류"},
+		{"valid", "max_response_time: 1s\nrequests: [{folder: /Users/, max_response_time: 300ms}]\nbudgets: [{p95: 200ms}]", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newSLAPolicy("", writeSLAFile(t, tt.content))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewSLAPolicyNothingConfigured(t *testing.T) {
+	policy, err := newSLAPolicy("", "")
+	if policy != nil || err != nil {
+		t.Fatalf("got %v, %v; want nil, nil", policy, err)
+	}
+	if _, err := newSLAPolicy("fast", ""); err == nil {
+		t.Fatal("expected error for invalid -max-response-time")
+	}
+}
+
+func TestSLALimitFor(t *testing.T) {
+	path := writeSLAFile(t, `
+max_response_time: 1s
+requests:
+  - folder: Users
+    max_response_time: 500ms
+  - folder: Users/Admin
+    max_response_time: 300ms
+  - name: Search
+    max_response_time: 2s
+`)
+	policy, err := newSLAPolicy("", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name, folder string
+		want         time.Duration
+	}{
+		{"List", "", time.Second},
+		{"List", "Users", 500 * time.Millisecond},
+		{"List", "Users/Admin/Roles", 300 * time.Millisecond},
+		{"Search", "Users/Admin", 2 * time.Second},
+		{"List", "UsersX", time.Second},
+	}
+	for _, tt := range tests {
+		got := policy.limitFor(&TestResult{Name: tt.name, Folder: tt.folder})
+		if got != tt.want {
+			t.Errorf("limitFor(%q, %q) = %v, want %v", tt.name, tt.folder, got, tt.want)
+		}
+	}
+}
+
+func TestSLACheckBudgets(t *testing.T) {
+	policy, err := newSLAPolicy("", writeSLAFile(t, "budgets: [{folder: Users, max: 100ms}]"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := []TestResult{
+		{Name: "a", Folder: "Users", StatusCode: 200, ResponseTime: 50 * time.Millisecond},
+		{Name: "b", Folder: "Users", StatusCode: 200, ResponseTime: 150 * time.Millisecond},
+		{Name: "c", Folder: "Orders", StatusCode: 200, ResponseTime: time.Second},
+	}
+	violations := policy.checkBudgets(results)
+	if len(violations) != 1 || !strings.Contains(violations[0], "Users 폴더 max") {
+		t.Fatalf("violations = %v", violations)
+	}
+}
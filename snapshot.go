@@ -0,0 +1,336 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// 무시한 필드에 대신 기록하는 값 (필드가 있다는 사실은 비교한다)
+const snapshotIgnored = "<ignored>"
+
+// 검증 메시지에 포함할 최대 차이 개수 (전체 차이는 TestResult.SnapshotDiff에 기록)
+const maxSnapshotDiffInMessage = 5
+
+// 파일 이름에 쓸 수 없는 문자
+var unsafeFileChars = regexp.MustCompile(`[<>:"/\\|?*\x00-\x1f]`)
+
+// 응답 본문 스냅샷 저장소 (-snapshot-dir)
+// 스냅샷은 <디렉토리>/<컬렉션 파일 경로>/<폴더 경로>/<요청 이름>.snap 에 저장한다
+// 컬렉션 파일 경로는 -dir 기준 상대 경로라서 하위 디렉토리의 같은 이름 컬렉션끼리 섞이지 않는다
+type snapshotStore struct {
+	dir    string
+	root   string      // 컬렉션을 찾은 디렉토리 (-file 실행이면 "", 파일 이름만 사용)
+	update bool        // true이면 비교하지 않고 현재 응답으로 다시 저장
+	ignore []*jsonPath // 비교에서 제외할 필드 (ID, 시각, 토큰 등)
+}
+
+func newSnapshotStore(dir, root string, update bool, ignore string) (*snapshotStore, error) {
+	store := &snapshotStore{dir: dir, root: root, update: update}
+	for _, pattern := range strings.Split(ignore, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern == "" {
+			continue
+		}
+		path, err := parseJSONPath(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("잘못된 -snapshot-ignore 값: %v", err)
+		}
+		store.ignore = append(store.ignore, path)
+	}
+	return store, nil
+}
+
+// 컬렉션 하나를 실행하는 동안의 스냅샷 경로 관리 (이름이 같은 요청은 " (2)" 등을 붙여 구분)
+type collectionSnapshots struct {
+	store  *snapshotStore
+	dir    string
+	redact func(result *TestResult, body string) string // 저장/비교 전에 본문의 민감 정보를 가림 (nil이면 그대로)
+
+	mu   sync.Mutex
+	seen map[string]int
+}
+
+// 컬렉션 파일별 스냅샷 디렉토리 (저장소가 nil이면 nil)
+// redact는 리포트와 같은 마스킹 규칙으로, 저장하는 스냅샷과 비교하는 양쪽 본문에 모두 적용한다
+func (s *snapshotStore) forCollection(file string, redact func(result *TestResult, body string) string) *collectionSnapshots {
+	if s == nil {
+		return nil
+	}
+	parts := []string{s.dir}
+	for _, part := range strings.Split(s.collectionPath(file), "/") {
+		parts = append(parts, safeFileName(part))
+	}
+	return &collectionSnapshots{
+		store:  s,
+		dir:    filepath.Join(parts...),
+		redact: redact,
+		seen:   make(map[string]int),
+	}
+}
+
+// 스냅샷 디렉토리 안에서 컬렉션이 쓰는 경로 ("/" 구분, 확장자 제외)
+// root 밖의 파일이거나 root가 없으면 파일 이름만 사용
+func (s *snapshotStore) collectionPath(file string) string {
+	path := filepath.Base(file)
+	if s.root != "" {
+		if rel, err := filepath.Rel(s.root, file); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			path = rel
+		}
+	}
+	return strings.TrimSuffix(filepath.ToSlash(path), filepath.Ext(path))
+}
+
+// 요청의 스냅샷 파일 경로
+func (c *collectionSnapshots) path(result *TestResult) string {
+	parts := []string{c.dir}
+	if result.Folder != "" {
+		for _, folder := range strings.Split(result.Folder, "/") {
+			parts = append(parts, safeFileName(folder))
+		}
+	}
+
+	name := safeFileName(result.Name)
+	c.mu.Lock()
+	key := result.Folder + "/" + name
+	c.seen[key]++
+	if n := c.seen[key]; n > 1 {
+		name += fmt.Sprintf(" (%d)", n)
+	}
+	c.mu.Unlock()
+
+	return filepath.Join(append(parts, name+".snap")...)
+}
+
+// 응답 본문을 스냅샷과 비교한 결과 추가 (nil이면 아무것도 하지 않음)
+// 스냅샷이 없으면 새로 저장하고 성공으로 처리한다
+func (c *collectionSnapshots) evaluate(result *TestResult) {
+	if c == nil {
+		return
+	}
+	path := c.path(result)
+	name := "스냅샷 " + filepath.Base(path)
+	current := c.store.normalize(c.mask(result, result.ResponseBody))
+
+	save := func(label string) {
+		assertion := AssertionResult{Name: name + " (" + label + ")", Passed: true}
+		if err := writeSnapshot(path, current); err != nil {
+			assertion.Passed = false
+			assertion.Message = fmt.Sprintf("스냅샷 저장 실패: %v", err)
+		}
+		result.Assertions = append(result.Assertions, assertion)
+	}
+
+	stored, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		save("생성됨")
+		return
+	}
+	if err != nil {
+		result.Assertions = append(result.Assertions, AssertionResult{Name: name, Message: fmt.Sprintf("스냅샷 읽기 실패: %v", err)})
+		return
+	}
+
+	// 나중에 추가한 무시 규칙도 적용되도록 저장된 스냅샷도 다시 정규화해서 비교
+	diff := snapshotDiff(c.store.normalize(c.mask(result, string(stored))), current)
+	if len(diff) == 0 {
+		result.Assertions = append(result.Assertions, AssertionResult{Name: name, Passed: true})
+		return
+	}
+	if c.store.update {
+		save("갱신됨")
+		return
+	}
+
+	result.SnapshotDiff = diff
+	messages := diff
+	if len(messages) > maxSnapshotDiffInMessage {
+		messages = append(append([]string{}, diff[:maxSnapshotDiffInMessage]...), fmt.Sprintf("외 %d개", len(diff)-maxSnapshotDiffInMessage))
+	}
+	result.Assertions = append(result.Assertions, AssertionResult{
+		Name:    name,
+		Message: fmt.Sprintf("스냅샷 %s과(와) 다름: %s", filepath.Base(path), strings.Join(messages, ", ")),
+	})
+}
+
+// 마스킹 규칙을 적용한 본문 (규칙이 없으면 그대로)
+func (c *collectionSnapshots) mask(result *TestResult, body string) string {
+	if c.redact == nil {
+		return body
+	}
+	return c.redact(result, body)
+}
+
+func writeSnapshot(path, content string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(content), 0644)
+}
+
+// 비교용으로 정규화한 본문
+// JSON이면 무시할 필드를 바꾸고 키를 정렬해 들여쓴 형태, 그 외에는 줄바꿈만 통일한 텍스트
+func (s *snapshotStore) normalize(body string) string {
+	document, ok := decodeSnapshotJSON(body)
+	if !ok {
+		text := strings.ReplaceAll(body, "\r\n", "\n")
+		return strings.TrimRight(text, "\n") + "\n"
+	}
+
+	for _, path := range s.ignore {
+		document = path.replaceAll(document, snapshotIgnored)
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return body
+	}
+	return buf.String()
+}
+
+// 숫자를 원래 표기 그대로 유지하며 JSON 해석 (JSON이 아니면 ok=false)
+func decodeSnapshotJSON(text string) (interface{}, bool) {
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, false
+	}
+	if decoder.More() {
+		return nil, false
+	}
+	return document, true
+}
+
+// 저장된 스냅샷과 현재 본문의 차이 목록 (같으면 빈 목록)
+// 둘 다 JSON이면 경로별 구조 비교, 아니면 처음 달라지는 줄을 알려준다
+func snapshotDiff(stored, current string) []string {
+	if stored == current {
+		return nil
+	}
+
+	expected, ok1 := decodeSnapshotJSON(stored)
+	actual, ok2 := decodeSnapshotJSON(current)
+	if ok1 && ok2 {
+		var diff []string
+		diffJSON("$", expected, actual, &diff)
+		return diff
+	}
+	if ok1 != ok2 {
+		return []string{"본문 형식이 바뀜 (JSON ↔ 텍스트)"}
+	}
+
+	storedLines := strings.Split(stored, "\n")
+	currentLines := strings.Split(current, "\n")
+	for i := 0; ; i++ {
+		if i >= len(storedLines) || i >= len(currentLines) || storedLines[i] != currentLines[i] {
+			var want, got string
+			if i < len(storedLines) {
+				want = storedLines[i]
+			}
+			if i < len(currentLines) {
+				got = currentLines[i]
+			}
+			return []string{fmt.Sprintf("%d번째 줄: %q → %q", i+1, truncate(80, want), truncate(80, got))}
+		}
+	}
+}
+
+// JSON 값 구조 비교 (차이마다 "경로: 설명" 한 줄)
+func diffJSON(path string, expected, actual interface{}, diff *[]string) {
+	switch want := expected.(type) {
+	case map[string]interface{}:
+		got, ok := actual.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(want)+len(got))
+		for key := range want {
+			keys = append(keys, key)
+		}
+		for key := range got {
+			if _, exists := want[key]; !exists {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			child := path + jsonPathKey(key)
+			wantValue, inWant := want[key]
+			gotValue, inGot := got[key]
+			switch {
+			case !inGot:
+				*diff = append(*diff, fmt.Sprintf("%s: 없어짐 (기존 %s)", child, snapshotValue(wantValue)))
+			case !inWant:
+				*diff = append(*diff, fmt.Sprintf("%s: 추가됨 (%s)", child, snapshotValue(gotValue)))
+			default:
+				diffJSON(child, wantValue, gotValue, diff)
+			}
+		}
+		return
+
+	case []interface{}:
+		got, ok := actual.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(want) || i < len(got); i++ {
+			child := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(got):
+				*diff = append(*diff, fmt.Sprintf("%s: 없어짐 (기존 %s)", child, snapshotValue(want[i])))
+			case i >= len(want):
+				*diff = append(*diff, fmt.Sprintf("%s: 추가됨 (%s)", child, snapshotValue(got[i])))
+			default:
+				diffJSON(child, want[i], got[i], diff)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		*diff = append(*diff, fmt.Sprintf("%s: %s → %s", path, snapshotValue(expected), snapshotValue(actual)))
+	}
+}
+
+// 경로에 붙일 키 표기 (식별자가 아니면 ['key'])
+func jsonPathKey(key string) string {
+	for i, r := range key {
+		if !(r == '_' || r == '$' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || i > 0 && r >= '0' && r <= '9') {
+			return "['" + key + "']"
+		}
+	}
+	if key == "" {
+		return "['']"
+	}
+	return "." + key
+}
+
+// 차이 메시지에 넣을 값 표기 (길면 자름)
+func snapshotValue(value interface{}) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprint(value)
+	}
+	return truncate(60, strings.TrimSpace(buf.String()))
+}
+
+// 파일/디렉토리 이름에 쓸 수 없는 문자를 _로 바꿈
+func safeFileName(name string) string {
+	name = strings.TrimSpace(unsafeFileChars.ReplaceAllString(name, "_"))
+	if name == "" || name == "." || name == ".." {
+		return "_"
+	}
+	return name
+}
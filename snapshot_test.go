@@ -0,0 +1,59 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSnapshotCollectionPath(t *testing.T) {
+	root := filepath.Join("postman", "api")
+	tests := []struct {
+		root, file string
+		want       string
+	}{
+		{"", filepath.Join(root, "a", "users.json"), "users"},
+		{root, filepath.Join(root, "users.json"), "users"},
+		{root, filepath.Join(root, "a", "users.json"), "a/users"},
+		{root, filepath.Join(root, "b", "users.postman_collection.json"), "b/users.postman_collection"},
+		{root, filepath.Join("other", "users.json"), "users"},
+	}
+	for _, tt := range tests {
+		store := &snapshotStore{root: tt.root}
+		if got := store.collectionPath(tt.file); got != tt.want {
+			t.Errorf("collectionPath(%q, %q) = %q, want %q", tt.root, tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestSnapshotSameNamedCollections(t *testing.T) {
+	root := t.TempDir()
+	store, err := newSnapshotStore(filepath.Join(root, "snaps"), root, false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 하위 디렉토리만 다른 같은 이름의 컬렉션은 서로의 스냅샷을 읽거나 덮어쓰지 않아야 한다
+	run := func(file, body string) AssertionResult {
+		result := TestResult{Name: "List", Folder: "Users", ResponseBody: body}
+		store.forCollection(filepath.Join(root, file), nil).evaluate(&result)
+		if len(result.Assertions) != 1 {
+			t.Fatalf("%s: assertions = %+v", file, result.Assertions)
+		}
+		return result.Assertions[0]
+	}
+
+	for round := 1; round <= 2; round++ {
+		for _, tt := range []struct{ file, body string }{
+			{filepath.Join("a", "users.json"), `{"team": "a"}`},
+			{filepath.Join("b", "users.json"), `{"team": "b"}`},
+		} {
+			if got := run(tt.file, tt.body); !got.Passed {
+				t.Errorf("round %d %s: %s", round, tt.file, got.Message)
+			}
+		}
+	}
+
+	if got := run(filepath.Join("b", "users.json"), `{"team": "a"}`); got.Passed {
+		t.Errorf("b/users.json compared against a's snapshot: %+v", got)
+	}
+}
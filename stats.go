@@ -0,0 +1,221 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 응답 시간 통계 (응답을 받은 요청만 포함, 건너뛴 요청과 연결 실패는 제외)
+type LatencyStats struct {
+	Count  int           `json:"count"`
+	Min    time.Duration `json:"min"`
+	Max    time.Duration `json:"max"`
+	Mean   time.Duration `json:"mean"`
+	StdDev time.Duration `json:"stddev"` // 모표준편차
+	P50    time.Duration `json:"p50"`
+	P90    time.Duration `json:"p90"`
+	P95    time.Duration `json:"p95"`
+	P99    time.Duration `json:"p99"`
+}
+
+// 이름(요청 이름 또는 폴더 경로)별 통계
+type LatencyGroup struct {
+	Name string `json:"name"`
+	LatencyStats
+}
+
+// 컬렉션 하나의 응답 시간 통계
+type CollectionLatency struct {
+	Overall  LatencyStats   `json:"overall"`
+	Folders  []LatencyGroup `json:"folders,omitempty"`  // 하위 폴더의 요청도 상위 폴더에 포함
+	Requests []LatencyGroup `json:"requests,omitempty"` // "폴더 경로/요청 이름"별, 같은 폴더의 같은 이름 요청은 하나로 합산
+}
+
+// 응답 시간 분포 막대 하나
+type HistogramBucket struct {
+	From    time.Duration
+	To      time.Duration
+	Count   int
+	Percent float64 // 가장 많은 구간 대비 비율 (막대 길이, 0~100)
+}
+
+// 통계에 포함할 결과인지 여부
+func hasLatency(result TestResult) bool {
+	return !result.Skipped && result.StatusCode != 0
+}
+
+// 실행 결과로부터 컬렉션 통계 계산 (그룹은 처음 나온 순서 유지)
+func newCollectionLatency(results []TestResult) *CollectionLatency {
+	var all []time.Duration
+	folders := newDurationGroups()
+	requests := newDurationGroups()
+
+	for _, result := range results {
+		if !hasLatency(result) {
+			continue
+		}
+		all = append(all, result.ResponseTime)
+		requests.add(latencyRequestName(result), result.ResponseTime)
+
+		if result.Folder != "" {
+			parts := strings.Split(result.Folder, "/")
+			for i := range parts {
+				folders.add(strings.Join(parts[:i+1], "/"), result.ResponseTime)
+			}
+		}
+	}
+
+	if len(all) == 0 {
+		return nil
+	}
+	return &CollectionLatency{
+		Overall:  newLatencyStats(all),
+		Folders:  folders.stats(),
+		Requests: requests.stats(),
+	}
+}
+
+// 요청별 통계의 이름 (다른 폴더의 같은 이름 요청은 따로 계산하도록 폴더 경로를 붙임)
+func latencyRequestName(result TestResult) string {
+	if result.Folder == "" {
+		return result.Name
+	}
+	return result.Folder + "/" + result.Name
+}
+
+// 이름별 응답 시간 모음
+type durationGroups struct {
+	names  []string
+	values map[string][]time.Duration
+}
+
+func newDurationGroups() *durationGroups {
+	return &durationGroups{values: make(map[string][]time.Duration)}
+}
+
+func (g *durationGroups) add(name string, d time.Duration) {
+	if _, ok := g.values[name]; !ok {
+		g.names = append(g.names, name)
+	}
+	g.values[name] = append(g.values[name], d)
+}
+
+func (g *durationGroups) stats() []LatencyGroup {
+	groups := make([]LatencyGroup, 0, len(g.names))
+	for _, name := range g.names {
+		groups = append(groups, LatencyGroup{Name: name, LatencyStats: newLatencyStats(g.values[name])})
+	}
+	return groups
+}
+
+func newLatencyStats(durations []time.Duration) LatencyStats {
+	if len(durations) == 0 {
+		return LatencyStats{}
+	}
+
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var sum float64
+	for _, d := range sorted {
+		sum += float64(d)
+	}
+	mean := sum / float64(len(sorted))
+
+	var variance float64
+	for _, d := range sorted {
+		variance += (float64(d) - mean) * (float64(d) - mean)
+	}
+	variance /= float64(len(sorted))
+
+	return LatencyStats{
+		Count:  len(sorted),
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+		Mean:   time.Duration(mean),
+		StdDev: time.Duration(math.Sqrt(variance)),
+		P50:    percentile(sorted, 50),
+		P90:    percentile(sorted, 90),
+		P95:    percentile(sorted, 95),
+		P99:    percentile(sorted, 99),
+	}
+}
+
+// 정렬된 값의 백분위수 (nearest-rank 방식)
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func latencies(summaries []*TestSummary) []time.Duration {
+	var all []time.Duration
+	for _, summary := range summaries {
+		for _, result := range summary.Results {
+			if hasLatency(result) {
+				all = append(all, result.ResponseTime)
+			}
+		}
+	}
+	return all
+}
+
+// 최솟값~최댓값을 같은 폭으로 나눈 응답 시간 분포
+func latencyHistogram(durations []time.Duration, buckets int) []HistogramBucket {
+	if len(durations) == 0 || buckets < 1 {
+		return nil
+	}
+
+	min, max := durations[0], durations[0]
+	for _, d := range durations {
+		if d < min {
+			min = d
+		}
+		if d > max {
+			max = d
+		}
+	}
+
+	width := (max - min) / time.Duration(buckets)
+	if width <= 0 {
+		return []HistogramBucket{{From: min, To: max, Count: len(durations), Percent: 100}}
+	}
+
+	histogram := make([]HistogramBucket, buckets)
+	for i := range histogram {
+		histogram[i].From = min + width*time.Duration(i)
+		histogram[i].To = histogram[i].From + width
+	}
+	histogram[buckets-1].To = max
+
+	for _, d := range durations {
+		i := int((d - min) / width)
+		if i >= buckets {
+			i = buckets - 1
+		}
+		histogram[i].Count++
+	}
+
+	largest := 0
+	for _, bucket := range histogram {
+		if bucket.Count > largest {
+			largest = bucket.Count
+		}
+	}
+	for i := range histogram {
+		histogram[i].Percent = percent(histogram[i].Count, largest)
+	}
+	return histogram
+}
+
+// 통계 한 줄 요약 (텍스트 리포트 등에서 사용)
+func (s LatencyStats) String() string {
+	return fmt.Sprintf("n=%d min=%s p50=%s p90=%s p95=%s p99=%s max=%s mean=%s sd=%s",
+		s.Count, formatDuration(s.Min), formatDuration(s.P50), formatDuration(s.P90), formatDuration(s.P95),
+		formatDuration(s.P99), formatDuration(s.Max), formatDuration(s.Mean), formatDuration(s.StdDev))
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewCollectionLatencyGroups(t *testing.T) {
+	ms := time.Millisecond
+	latency := newCollectionLatency([]TestResult{
+		{Name: "Get item", Folder: "users", StatusCode: 200, ResponseTime: 10 * ms},
+		{Name: "Get item", Folder: "orders", StatusCode: 200, ResponseTime: 30 * ms},
+		{Name: "Get item", Folder: "users", StatusCode: 200, ResponseTime: 20 * ms},
+		{Name: "Health", StatusCode: 200, ResponseTime: 5 * ms},
+		{Name: "List", Folder: "users/admin", StatusCode: 500, ResponseTime: 40 * ms},
+		{Name: "Skipped", Folder: "users", Skipped: true},
+		{Name: "Refused", Folder: "users", ResponseTime: time.Second},
+	})
+
+	names := func(groups []LatencyGroup) []string {
+		var list []string
+		for _, group := range groups {
+			list = append(list, group.Name)
+		}
+		return list
+	}
+	if got, want := names(latency.Requests), []string{"users/Get item", "orders/Get item", "Health", "users/admin/List"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("request groups = %q, want %q", got, want)
+	}
+	if got, want := names(latency.Folders), []string{"users", "orders", "users/admin"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("folder groups = %q, want %q", got, want)
+	}
+	if users := latency.Requests[0]; users.Count != 2 || users.Min != 10*ms || users.Max != 20*ms {
+		t.Errorf("users/Get item = %+v", users.LatencyStats)
+	}
+	if users := latency.Folders[0]; users.Count != 3 || users.Max != 40*ms {
+		t.Errorf("users folder = %+v (subfolders included)", users.LatencyStats)
+	}
+	if latency.Overall.Count != 5 {
+		t.Errorf("overall count = %d, want 5", latency.Overall.Count)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+	for p, want := range map[float64]time.Duration{50: 50 * time.Millisecond, 95: 95 * time.Millisecond, 99: 99 * time.Millisecond, 100: 100 * time.Millisecond} {
+		if got := percentile(sorted, p); got != want {
+			t.Errorf("p%v = %v, want %v", p, got, want)
+		}
+	}
+	if got := percentile([]time.Duration{7 * time.Millisecond}, 99); got != 7*time.Millisecond {
+		t.Errorf("single value p99 = %v", got)
+	}
+}
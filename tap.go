@@ -0,0 +1,103 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+func init() {
+	registerReportFormat("tap", func() ReportGenerator { return tapReport{} })
+}
+
+type tapReport struct{}
+
+func (tapReport) Extension() string { return "tap" }
+
+// TAP version 13 (https://testanything.org/tap-version-13-specification.html)
+// 요청 하나가 테스트 하나, 검증 결과는 들여쓴 하위 테스트로 출력한다
+func (tapReport) Generate(summaries []*TestSummary) (string, error) {
+	data := newReportData(summaries)
+	var sb strings.Builder
+
+	sb.WriteString("TAP version 13\n")
+	sb.WriteString(fmt.Sprintf("1..%d\n", len(data.Results)))
+
+	for i, result := range data.Results {
+		name := tapDescription(result)
+
+		if result.Skipped {
+			sb.WriteString(fmt.Sprintf("ok %d - %s # SKIP %s\n", i+1, name, tapEscape(result.SkipReason)))
+			continue
+		}
+
+		if len(result.Assertions) > 0 {
+			sb.WriteString(fmt.Sprintf("    # Subtest: %s\n", name))
+			sb.WriteString(fmt.Sprintf("    1..%d\n", len(result.Assertions)))
+			for j, assertion := range result.Assertions {
+				sb.WriteString(fmt.Sprintf("    %s %d - %s\n", tapStatus(assertion.Passed), j+1, tapEscape(assertion.Name)))
+				if !assertion.Passed && assertion.Message != "" {
+					writeTAPDiagnostics(&sb, "      ", [][2]interface{}{{"message", assertion.Message}})
+				}
+			}
+		}
+
+		sb.WriteString(fmt.Sprintf("%s %d - %s\n", tapStatus(result.Success), i+1, name))
+		diagnostics := [][2]interface{}{
+			{"method", result.Method},
+			{"url", result.URL},
+			{"status", result.StatusCode},
+			{"response_time_ms", milliseconds(result.ResponseTime)},
+		}
+		if result.ErrorMessage != "" {
+			diagnostics = append(diagnostics, [2]interface{}{"message", result.ErrorMessage})
+		}
+		writeTAPDiagnostics(&sb, "  ", diagnostics)
+	}
+
+	// 응답 시간 통계는 TAP 소비자가 무시하는 주석으로 출력
+	for _, summary := range summaries {
+		if summary.Latency != nil {
+			sb.WriteString(fmt.Sprintf("# latency %s: %s\n", tapEscape(summary.CollectionName), summary.Latency.Overall))
+		}
+	}
+	if data.Latency.Count > 0 {
+		sb.WriteString(fmt.Sprintf("# latency total: %s\n", data.Latency))
+	}
+
+	return sb.String(), nil
+}
+
+func tapStatus(passed bool) string {
+	if passed {
+		return "ok"
+	}
+	return "not ok"
+}
+
+// 테스트 설명 (컬렉션 / 폴더 / 요청 이름)
+func tapDescription(result ReportResult) string {
+	parts := []string{result.Collection}
+	if result.Folder != "" {
+		parts = append(parts, result.Folder)
+	}
+	parts = append(parts, result.Name)
+	return tapEscape(strings.Join(parts, " / "))
+}
+
+// 설명 안의 #은 지시어(SKIP, TODO)로 해석되지 않도록 이스케이프하고 줄바꿈은 공백으로 바꾼다
+func tapEscape(text string) string {
+	text = strings.ReplaceAll(text, "\\", "\\\\")
+	text = strings.ReplaceAll(text, "#", "\\#")
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// YAML 진단 블록 출력 (값은 JSON 표기로 써서 특수문자가 있어도 올바른 YAML이 되게 한다)
+func writeTAPDiagnostics(sb *strings.Builder, indent string, fields [][2]interface{}) {
+	sb.WriteString(indent + "---\n")
+	for _, field := range fields {
+		value, _ := json.Marshal(field[1])
+		sb.WriteString(fmt.Sprintf("%s%s: %s\n", indent, field[0], value))
+	}
+	sb.WriteString(indent + "...\n")
+}
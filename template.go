@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 리포트 템플릿(-template, -text-template)에 전달되는 데이터
+// 필드 이름은 사용자 템플릿에서 그대로 사용되므로 바꿀 때는 README의 설명도 함께 고쳐야 한다
+type ReportData struct {
+	Summaries   []*TestSummary    // 컬렉션별 실행 결과 (실행 순서)
+	Results     []ReportResult    // 모든 컬렉션의 요청 결과를 한 목록으로 펼친 것
+	Totals      ReportTotals      // 전체 집계
+	Environment ReportEnvironment // 실행 환경 정보
+	Timings     ReportTimings     // 전체 실행 시간 정보
+	Latency     LatencyStats      // 모든 컬렉션을 합친 응답 시간 통계
+	Histogram   []HistogramBucket // 응답 시간 분포 (10개 구간)
+	Coverage    *APICoverage      // OpenAPI 명세 대비 커버리지 (-openapi를 쓰지 않았으면 nil)
+	Methods     []string          // 실행된 HTTP 메서드 목록 (처음 나온 순서)
+	Folders     []string          // 실행된 폴더와 그 상위 폴더 경로 목록 (처음 나온 순서)
+}
+
+// 컬렉션 이름이 함께 붙은 요청 결과 (TestResult의 필드를 그대로 사용할 수 있음)
+type ReportResult struct {
+	Collection string
+	TestResult
+}
+
+type ReportTotals struct {
+	Collections int
+	Tests       int // 건너뛴 요청은 제외
+	Passed      int
+	Failed      int
+	Skipped     int
+	SuccessRate float64 // 0~100
+}
+
+type ReportEnvironment struct {
+	Version     string // postman-tester 버전
+	Hostname    string
+	OS          string
+	Arch        string
+	DryRun      bool
+	GeneratedAt time.Time // 리포트 생성 시각
+}
+
+type ReportTimings struct {
+	StartTime       time.Time     // 첫 컬렉션 시작 시각
+	EndTime         time.Time     // 마지막 컬렉션 종료 시각
+	TotalTime       time.Duration // 컬렉션별 실행 시간의 합
+	MaxResponseTime time.Duration // 가장 느린 요청의 응답 시간
+}
+
+func newReportData(summaries []*TestSummary) ReportData {
+	hostname, _ := os.Hostname()
+	data := ReportData{
+		Summaries: summaries,
+		Results:   make([]ReportResult, 0),
+		Environment: ReportEnvironment{
+			Version:     version,
+			Hostname:    hostname,
+			OS:          runtime.GOOS,
+			Arch:        runtime.GOARCH,
+			GeneratedAt: time.Now(),
+		},
+	}
+	data.Totals.Collections = len(summaries)
+
+	for _, summary := range summaries {
+		data.Totals.Tests += summary.TotalTests
+		data.Totals.Passed += summary.PassedTests
+		data.Totals.Failed += summary.FailedTests
+		data.Totals.Skipped += summary.SkippedTests
+		data.Environment.DryRun = data.Environment.DryRun || summary.DryRun
+
+		data.Timings.TotalTime += summary.TotalTime
+		if data.Timings.StartTime.IsZero() || summary.StartTime.Before(data.Timings.StartTime) {
+			data.Timings.StartTime = summary.StartTime
+		}
+		if summary.EndTime.After(data.Timings.EndTime) {
+			data.Timings.EndTime = summary.EndTime
+		}
+
+		for _, result := range summary.Results {
+			data.Results = append(data.Results, ReportResult{Collection: summary.CollectionName, TestResult: result})
+			if result.ResponseTime > data.Timings.MaxResponseTime {
+				data.Timings.MaxResponseTime = result.ResponseTime
+			}
+			if result.Method != "" && !containsString(data.Methods, result.Method) {
+				data.Methods = append(data.Methods, result.Method)
+			}
+			// 상위 폴더로도 거를 수 있도록 모든 상위 경로 포함
+			for _, node := range folderPaths(result.Folder) {
+				if !containsString(data.Folders, node) {
+					data.Folders = append(data.Folders, node)
+				}
+			}
+		}
+	}
+
+	all := latencies(summaries)
+	data.Latency = newLatencyStats(all)
+	data.Histogram = latencyHistogram(all, 10)
+	data.Coverage = mergeCoverage(summaries)
+
+	if data.Totals.Tests > 0 {
+		data.Totals.SuccessRate = float64(data.Totals.Passed) / float64(data.Totals.Tests) * 100
+	}
+	return data
+}
+
+// 리포트 템플릿에서 사용할 수 있는 함수 (html/template, text/template 공용)
+var reportTemplateFuncs = map[string]interface{}{
+	"duration":       formatDuration,
+	"ms":             milliseconds,
+	"percent":        percent,
+	"percentOf":      percentOf,
+	"truncate":       truncate,
+	"prettyBody":     prettyBody,
+	"toJSON":         toJSON,
+	"sortedHeaders":  sortedRequestHeaders,
+	"responseHeader": responseHeaderList,
+	"folderTree":     folderTree,
+	"resultStatus":   resultStatus,
+	"latencyRow":     latencyRow,
+	"byteSize":       formatBytes,
+}
+
+// 사람이 읽기 좋은 시간 표기 (1초 미만은 ms, 그 이상은 초)
+func formatDuration(d time.Duration) string {
+	if d < 10*time.Millisecond {
+		return fmt.Sprintf("%.1fms", milliseconds(d))
+	}
+	if d < time.Second {
+		return fmt.Sprintf("%.0fms", milliseconds(d))
+	}
+	return fmt.Sprintf("%.2fs", d.Seconds())
+}
+
+// 개수 비율 (0~100, 전체가 0이면 0)
+func percent(part, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(part) / float64(total) * 100
+}
+
+// 기준값 대비 비율 (차트 막대 너비 계산용, 0~100)
+func percentOf(value, max time.Duration) float64 {
+	if max <= 0 {
+		return 0
+	}
+	return float64(value) / float64(max) * 100
+}
+
+// 최대 n글자까지만 남기고 잘린 경우 말줄임표 추가 (글자 단위)
+func truncate(n int, text string) string {
+	if utf8.RuneCountInString(text) <= n {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:n]) + "…"
+}
+
+// JSON 본문은 들여쓰기해서 보기 좋게 정리 (JSON이 아니면 그대로 반환)
+func prettyBody(body string) string {
+	trimmed := strings.TrimSpace(body)
+	if trimmed == "" || (trimmed[0] != '{' && trimmed[0] != '[') {
+		return body
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(trimmed), "", "  "); err != nil {
+		return body
+	}
+	return buf.String()
+}
+
+// 임의의 값을 들여쓴 JSON 문자열로 변환
+func toJSON(value interface{}) (string, error) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// 헤더 이름/값 쌍 (템플릿에서 정렬된 순서로 출력하기 위함)
+type headerEntry struct {
+	Name  string
+	Value string
+}
+
+func sortedRequestHeaders(headers map[string]string) []headerEntry {
+	h := http.Header{}
+	for key, value := range headers {
+		h[key] = []string{value}
+	}
+	return responseHeaderList(h)
+}
+
+func responseHeaderList(headers map[string][]string) []headerEntry {
+	var list []headerEntry
+	for _, key := range sortedHeaderKeys(headers) {
+		for _, value := range headers[key] {
+			list = append(list, headerEntry{Name: key, Value: value})
+		}
+	}
+	return list
+}
+
+// 트리 내비게이션에 표시할 폴더
+type folderNode struct {
+	Path  string // 전체 경로 (예: "Users/Admin")
+	Name  string // 마지막 폴더 이름
+	Depth int    // 최상위 폴더가 0
+}
+
+// 컬렉션 내 폴더 목록 (실행 순서대로, 상위 폴더 포함)
+func folderTree(summary *TestSummary) []folderNode {
+	var nodes []folderNode
+	seen := make(map[string]bool)
+	for _, result := range summary.Results {
+		if result.Folder == "" {
+			continue
+		}
+		parts := strings.Split(result.Folder, "/")
+		for i, path := range folderPaths(result.Folder) {
+			if !seen[path] {
+				seen[path] = true
+				nodes = append(nodes, folderNode{Path: path, Name: parts[i], Depth: i})
+			}
+		}
+	}
+	return nodes
+}
+
+// 폴더 경로와 모든 상위 경로 (예: "Users/Admin" → "Users", "Users/Admin", 빈 경로면 없음)
+func folderPaths(folder string) []string {
+	if folder == "" {
+		return nil
+	}
+	parts := strings.Split(folder, "/")
+	paths := make([]string, len(parts))
+	for i := range parts {
+		paths[i] = strings.Join(parts[:i+1], "/")
+	}
+	return paths
+}
+
+// 이름과 통계를 묶어 표의 한 행으로 전달 (템플릿에서 이름을 붙일 때 사용)
+func latencyRow(name string, stats LatencyStats) LatencyGroup {
+	return LatencyGroup{Name: name, LatencyStats: stats}
+}
+
+// 결과 상태 (passed, failed, skipped)
+func resultStatus(result TestResult) string {
+	switch {
+	case result.Skipped:
+		return "skipped"
+	case result.Success:
+		return "passed"
+	default:
+		return "failed"
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewReportData(t *testing.T) {
+	first := reportTestSummaries()[0]
+	second := &TestSummary{
+		CollectionName: "Orders",
+		FilePath:       "collections/orders.json",
+		TotalTests:     1,
+		PassedTests:    1,
+		TotalTime:      time.Second,
+		DryRun:         true,
+		Results: []TestResult{
+			{Name: "Get order", Folder: "Orders/Items/Detail", Method: "PATCH", StatusCode: 200, Success: true, ResponseTime: 300 * time.Millisecond},
+		},
+		StartTime: first.StartTime.Add(-time.Minute),
+		EndTime:   first.EndTime.Add(time.Minute),
+	}
+
+	data := newReportData([]*TestSummary{first, second})
+
+	want := ReportTotals{Collections: 2, Tests: 5, Passed: 2, Failed: 2, Skipped: 1, SuccessRate: 40}
+	if data.Totals != want {
+		t.Errorf("totals = %+v, want %+v", data.Totals, want)
+	}
+	if len(data.Results) != 5 || data.Results[0].Collection != "Users API" || data.Results[4].Collection != "Orders" || data.Results[4].Name != "Get order" {
+		t.Errorf("results not flattened in run order: %+v", data.Results)
+	}
+	if got := []string{"GET", "POST", "DELETE", "PATCH"}; !reflect.DeepEqual(data.Methods, got) {
+		t.Errorf("methods = %q, want %q", data.Methods, got)
+	}
+	folders := []string{"Users", "Users/Admin", "Orders", "Orders/Items", "Orders/Items/Detail"}
+	if !reflect.DeepEqual(data.Folders, folders) {
+		t.Errorf("folders = %q, want %q", data.Folders, folders)
+	}
+
+	timings := ReportTimings{
+		StartTime:       second.StartTime,
+		EndTime:         second.EndTime,
+		TotalTime:       2500 * time.Millisecond,
+		MaxResponseTime: 300 * time.Millisecond,
+	}
+	if data.Timings != timings {
+		t.Errorf("timings = %+v, want %+v", data.Timings, timings)
+	}
+	if !data.Environment.DryRun || data.Environment.Version != version || data.Environment.GeneratedAt.IsZero() {
+		t.Errorf("environment = %+v", data.Environment)
+	}
+
+	// 응답을 받은 요청만 통계에 포함 (연결 실패와 건너뛴 요청 제외)
+	if data.Latency.Count != 3 || data.Latency.Max != 300*time.Millisecond || len(data.Histogram) != 10 {
+		t.Errorf("latency = %+v, histogram buckets = %d", data.Latency, len(data.Histogram))
+	}
+	if data.Coverage != nil {
+		t.Errorf("coverage without -openapi = %+v", data.Coverage)
+	}
+}
+
+func TestNewReportDataEmpty(t *testing.T) {
+	data := newReportData(nil)
+	if data.Results == nil {
+		t.Error("Results must be an empty list so templates can range over it")
+	}
+	if data.Totals != (ReportTotals{}) || data.Latency.Count != 0 {
+		t.Errorf("empty run data = %+v, %+v", data.Totals, data.Latency)
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// 요청 하나의 단계별 소요 시간 (httptrace 기준, 리다이렉트가 있으면 마지막 요청 기준)
+// 연결을 재사용한 경우 DNS/Connect/TLS는 0
+type RequestTimings struct {
+	Blocked          time.Duration `json:"blocked"`           // 연결을 얻기까지 대기한 시간 (DNS/연결/TLS 제외)
+	DNS              time.Duration `json:"dns"`               // DNS 조회
+	Connect          time.Duration `json:"connect"`           // TCP 연결
+	TLS              time.Duration `json:"tls"`               // TLS 핸드셰이크
+	Send             time.Duration `json:"send"`              // 요청 전송
+	TTFB             time.Duration `json:"ttfb"`              // 요청 전송 완료 후 첫 응답 바이트까지 (서버 처리 시간)
+	Download         time.Duration `json:"download"`          // 응답 본문 수신
+	Total            time.Duration `json:"total"`             // 전송 시작부터 본문 수신 완료까지
+	ConnectionReused bool          `json:"connection_reused"` // keep-alive 연결 재사용 여부
+}
+
+// httptrace 이벤트 시각 기록 (DNS 조회 등은 다른 고루틴에서 호출될 수 있음)
+type requestTracer struct {
+	mu sync.Mutex
+
+	start        time.Time
+	gotConn      time.Time
+	dnsStart     time.Time
+	dnsDone      time.Time
+	connectStart time.Time
+	connectDone  time.Time
+	tlsStart     time.Time
+	tlsDone      time.Time
+	wroteRequest time.Time
+	firstByte    time.Time
+	reused       bool
+}
+
+func newRequestTracer() *requestTracer {
+	return &requestTracer{start: time.Now()}
+}
+
+func (t *requestTracer) clientTrace() *httptrace.ClientTrace {
+	record := func(at *time.Time) {
+		t.mu.Lock()
+		*at = time.Now()
+		t.mu.Unlock()
+	}
+
+	return &httptrace.ClientTrace{
+		DNSStart:             func(httptrace.DNSStartInfo) { record(&t.dnsStart) },
+		DNSDone:              func(httptrace.DNSDoneInfo) { record(&t.dnsDone) },
+		ConnectStart:         func(string, string) { record(&t.connectStart) },
+		ConnectDone:          func(string, string, error) { record(&t.connectDone) },
+		TLSHandshakeStart:    func() { record(&t.tlsStart) },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { record(&t.tlsDone) },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { record(&t.wroteRequest) },
+		GotFirstResponseByte: func() { record(&t.firstByte) },
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			t.gotConn = time.Now()
+			t.reused = info.Reused
+			t.mu.Unlock()
+		},
+	}
+}
+
+// 본문 수신이 끝난 시각(end)까지의 단계별 시간 계산 (기록되지 않은 단계는 0)
+func (t *requestTracer) timings(end time.Time) *RequestTimings {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	timings := &RequestTimings{
+		Total:            end.Sub(t.start),
+		ConnectionReused: t.reused,
+	}
+	if !t.reused {
+		timings.DNS = between(t.dnsStart, t.dnsDone)
+		timings.Connect = between(t.connectStart, t.connectDone)
+		timings.TLS = between(t.tlsStart, t.tlsDone)
+	}
+	if blocked := between(t.start, t.gotConn) - timings.DNS - timings.Connect - timings.TLS; blocked > 0 {
+		timings.Blocked = blocked
+	}
+	timings.Send = between(t.gotConn, t.wroteRequest)
+	timings.TTFB = between(t.wroteRequest, t.firstByte)
+	timings.Download = between(t.firstByte, end)
+	return timings
+}
+
+// 두 시각 사이의 시간 (둘 중 하나라도 기록되지 않았으면 0)
+func between(from, to time.Time) time.Duration {
+	if from.IsZero() || to.IsZero() || to.Before(from) {
+		return 0
+	}
+	return to.Sub(from)
+}
+
+// 단계별 시간 한 줄 요약 (예: "DNS 1.2ms | 연결 0.8ms | TLS 12ms | TTFB 45ms | 다운로드 0.3ms")
+func (t RequestTimings) String() string {
+	text := fmt.Sprintf("DNS %s | 연결 %s | TLS %s | TTFB %s | 다운로드 %s",
+		formatDuration(t.DNS), formatDuration(t.Connect), formatDuration(t.TLS), formatDuration(t.TTFB), formatDuration(t.Download))
+	if t.ConnectionReused {
+		text += " (연결 재사용)"
+	}
+	return text
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestApplyAuth(t *testing.T) {
+	vars := variableScope{"token": "abc", "key": "k y"}
+
+	tests := []struct {
+		name    string
+		auth    string // Postman auth 객체 JSON
+		url     string
+		header  string // Authorization 또는 API 키 헤더 값
+		wantURL string
+		wantErr bool
+	}{
+		{"v2.1 bearer", `{"type":"bearer","bearer":[{"key":"token","value":"{{token}}"}]}`,
+			"https://x/a", "Bearer abc", "https://x/a", false},
+		{"v2.0 bearer object", `{"type":"bearer","bearer":{"token":"{{token}}"}}`,
+			"https://x/a", "Bearer abc", "https://x/a", false},
+		{"basic", `{"type":"basic","basic":{"username":"u","password":"p"}}`,
+			"https://x/a", "Basic dTpw", "https://x/a", false},
+		{"apikey header", `{"type":"apikey","apikey":{"key":"Authorization","value":"{{token}}"}}`,
+			"https://x/a", "abc", "https://x/a", false},
+		{"apikey query keeps existing order", `{"type":"apikey","apikey":{"key":"{{key}}","value":"v&1","in":"query"}}`,
+			"https://x/a?z=1&b=%2F&a", "", "https://x/a?z=1&b=%2F&a&k+y=v%261", false},
+		{"noauth", `{"type":"noauth"}`, "https://x/a", "", "https://x/a", false},
+		{"unsupported", `{"type":"oauth2","oauth2":[{"key":"accessToken","value":"x"}]}`,
+			"https://x/a", "", "https://x/a", true},
+	}
+	for _, tt := range tests {
+		var auth Auth
+		if err := json.Unmarshal([]byte(tt.auth), &auth); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		req, _ := http.NewRequest("GET", tt.url, nil)
+		err := applyAuth(req, &auth, vars, nil)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+		if got := req.Header.Get("Authorization"); got != tt.header {
+			t.Errorf("%s: Authorization = %q, want %q", tt.name, got, tt.header)
+		}
+		if got := req.URL.String(); got != tt.wantURL {
+			t.Errorf("%s: URL = %s, want %s", tt.name, got, tt.wantURL)
+		}
+	}
+}